@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkloadRecommendation suggests right-sized limits for the pods of one workload
+type WorkloadRecommendation struct {
+	// Kind of the workload (Deployment, StatefulSet, or the owner kind/Pod for other pods)
+	Kind string `json:"kind"`
+
+	// Name of the workload in the team namespace
+	Name string `json:"name"`
+
+	// Replicas is the number of running pods the savings are computed for
+	Replicas int32 `json:"replicas"`
+
+	// CurrentCpuLimit is the CPU limit of each pod today (e.g., "2000m")
+	CurrentCpuLimit string `json:"currentCpuLimit"`
+
+	// RecommendedCpuLimit is the p95 of observed CPU plus headroom (e.g., "250m")
+	RecommendedCpuLimit string `json:"recommendedCpuLimit"`
+
+	// CurrentMemoryLimit is the Memory limit of each pod today (e.g., "4Gi")
+	// +optional
+	CurrentMemoryLimit string `json:"currentMemoryLimit,omitempty"`
+
+	// RecommendedMemoryLimit is the p95 of observed Memory plus headroom (e.g., "600Mi")
+	// +optional
+	RecommendedMemoryLimit string `json:"recommendedMemoryLimit,omitempty"`
+
+	// CpuSavings is the CPU freed across all replicas by applying the recommendation (e.g., "3500m")
+	CpuSavings string `json:"cpuSavings"`
+
+	// MemorySavings is the Memory freed across all replicas by applying the recommendation
+	// +optional
+	MemorySavings string `json:"memorySavings,omitempty"`
+}
+
+// UsageSample is the observed usage of one pod of a workload at a point in time.
+type UsageSample struct {
+	// Time the usage was observed
+	Time metav1.Time `json:"time"`
+
+	// CpuMilli is the observed CPU in millicores
+	CpuMilli int64 `json:"cpuMilli"`
+
+	// MemoryBytes is the observed Memory in bytes
+	MemoryBytes int64 `json:"memoryBytes"`
+}
+
+// WorkloadUsageHistory is the observed usage of a workload the recommendations are computed from.
+type WorkloadUsageHistory struct {
+	// Kind of the workload (as in WorkloadRecommendation)
+	Kind string `json:"kind"`
+
+	// Name of the workload in the team namespace
+	Name string `json:"name"`
+
+	// Samples are spread over the window, oldest first (at most 100)
+	Samples []UsageSample `json:"samples"`
+}
+
+// BudgetRecommendationSpec identifies the budget the recommendations are for
+type BudgetRecommendationSpec struct {
+	// BudgetName is the ProjectBudget (in the same namespace) these recommendations belong to
+	BudgetName string `json:"budgetName"`
+}
+
+// BudgetRecommendationStatus holds the latest right-sizing recommendations
+type BudgetRecommendationStatus struct {
+	// TeamName is the namespace the workloads run in
+	TeamName string `json:"teamName,omitempty"`
+
+	// Window is the period of observed usage the percentiles are computed over
+	Window metav1.Duration `json:"window,omitempty"`
+
+	// GeneratedAt is when the recommendations last changed
+	// +optional
+	GeneratedAt *metav1.Time `json:"generatedAt,omitempty"`
+
+	// Workloads are the most over-provisioned workloads, largest CPU savings first
+	// +optional
+	Workloads []WorkloadRecommendation `json:"workloads,omitempty"`
+
+	// PotentialCpuSavings is the CPU freed by applying every recommendation (e.g., "6000m")
+	// +optional
+	PotentialCpuSavings string `json:"potentialCpuSavings,omitempty"`
+
+	// PotentialMemorySavings is the Memory freed by applying every recommendation (e.g., "8Gi")
+	// +optional
+	PotentialMemorySavings string `json:"potentialMemorySavings,omitempty"`
+
+	// History is a bounded summary of the observed usage of each workload over the window. The
+	// controller reloads it after a restart, so recommendations don't start over from no samples.
+	// +optional
+	History []WorkloadUsageHistory `json:"history,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Budget",type=string,JSONPath=`.spec.budgetName`
+// +kubebuilder:printcolumn:name="CPU Savings",type=string,JSONPath=`.status.potentialCpuSavings`
+// +kubebuilder:printcolumn:name="Memory Savings",type=string,JSONPath=`.status.potentialMemorySavings`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BudgetRecommendation is the Schema for the budgetrecommendations API.
+// It is maintained by the ProjectBudget controller and garbage collected with its budget.
+type BudgetRecommendation struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec identifies the budget
+	// +required
+	Spec BudgetRecommendationSpec `json:"spec"`
+
+	// status holds the recommendations
+	// +optional
+	Status BudgetRecommendationStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// BudgetRecommendationList contains a list of BudgetRecommendation
+type BudgetRecommendationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []BudgetRecommendation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BudgetRecommendation{}, &BudgetRecommendationList{})
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BudgetReportSpec defines the time range and grouping of a showback report
+type BudgetReportSpec struct {
+	// Start of the reported range (defaults to the start of the current month, UTC)
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End of the reported range (defaults to now: the report is refreshed until the range closes)
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+
+	// GroupBy are labels of the team namespaces whose values aggregate the budgets (e.g., cost-center)
+	// +optional
+	GroupBy []string `json:"groupBy,omitempty"`
+
+	// BudgetSelector restricts the report to the matching ProjectBudgets of its namespace (all by default)
+	// +optional
+	BudgetSelector *metav1.LabelSelector `json:"budgetSelector,omitempty"`
+
+	// RefreshInterval is how often a report whose range is still open is regenerated
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// BudgetUsage is the recorded consumption of one budget over the reported range
+type BudgetUsage struct {
+	// Budget is the name of the ProjectBudget
+	Budget string `json:"budget"`
+
+	// Namespace is the team namespace governed by the budget
+	Namespace string `json:"namespace"`
+
+	// Labels are the values of the groupBy labels on the team namespace
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// CpuCoreHours consumed during the range (e.g., "372.000")
+	CpuCoreHours string `json:"cpuCoreHours"`
+
+	// MemoryGiBHours consumed during the range (e.g., "1488.000")
+	MemoryGiBHours string `json:"memoryGiBHours"`
+
+	// Cost of the consumption priced with the CostModel of the budget (empty if it has none)
+	// +optional
+	Cost string `json:"cost,omitempty"`
+
+	// Currency of the Cost (e.g., "USD")
+	// +optional
+	Currency string `json:"currency,omitempty"`
+
+	// Partial is true when the recorded history of the budget starts after the range does
+	// +optional
+	Partial bool `json:"partial,omitempty"`
+}
+
+// GroupUsage is the consumption of the budgets sharing the same groupBy label values
+type GroupUsage struct {
+	// Labels are the groupBy label values of the group
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Budgets is the number of budgets in the group
+	Budgets int32 `json:"budgets"`
+
+	// CpuCoreHours consumed by the group during the range
+	CpuCoreHours string `json:"cpuCoreHours"`
+
+	// MemoryGiBHours consumed by the group during the range
+	MemoryGiBHours string `json:"memoryGiBHours"`
+
+	// Cost of the group (empty when its budgets are priced in different currencies)
+	// +optional
+	Cost string `json:"cost,omitempty"`
+
+	// Currency of the Cost
+	// +optional
+	Currency string `json:"currency,omitempty"`
+}
+
+// BudgetReportStatus holds the latest rendering of the report
+type BudgetReportStatus struct {
+	// Start of the range the report covers
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End of the range the report covers
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+
+	// GeneratedAt is when the report was last computed
+	// +optional
+	GeneratedAt *metav1.Time `json:"generatedAt,omitempty"`
+
+	// ObservedGeneration is the generation of the spec the report was computed for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Budgets is the consumption of each budget, by namespace
+	// +optional
+	Budgets []BudgetUsage `json:"budgets,omitempty"`
+
+	// Groups are the totals per groupBy label values
+	// +optional
+	Groups []GroupUsage `json:"groups,omitempty"`
+
+	// ConfigMapName is the ConfigMap holding the CSV, JSON and Markdown renderings
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=br
+// +kubebuilder:printcolumn:name="Start",type=date,JSONPath=`.status.start`
+// +kubebuilder:printcolumn:name="End",type=date,JSONPath=`.status.end`
+// +kubebuilder:printcolumn:name="Generated",type=date,JSONPath=`.status.generatedAt`
+// +kubebuilder:printcolumn:name="ConfigMap",type=string,JSONPath=`.status.configMapName`
+
+// BudgetReport is the Schema for the budgetreports API.
+// It aggregates the consumption recorded by the ProjectBudgets of its namespace over a time
+// range, and publishes it as CSV, JSON and Markdown in a ConfigMap of the same name.
+type BudgetReport struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the range and grouping of the report
+	// +required
+	Spec BudgetReportSpec `json:"spec"`
+
+	// status holds the report
+	// +optional
+	Status BudgetReportStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// BudgetReportList contains a list of BudgetReport
+type BudgetReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []BudgetReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BudgetReport{}, &BudgetReportList{})
+}
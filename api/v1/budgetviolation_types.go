@@ -0,0 +1,140 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ViolationSource is what detected a budget violation
+// +kubebuilder:validation:Enum=Admission;Controller
+type ViolationSource string
+
+const (
+	// ViolationSourceAdmission is a pod creation checked by the webhook
+	ViolationSourceAdmission ViolationSource = "Admission"
+	// ViolationSourceController is a namespace found over budget by the ProjectBudget controller
+	ViolationSourceController ViolationSource = "Controller"
+)
+
+// ViolationAction is what happened to the violation
+// +kubebuilder:validation:Enum=Denied;DryRun;Detected
+type ViolationAction string
+
+const (
+	// ViolationDenied means the pod was rejected
+	ViolationDenied ViolationAction = "Denied"
+	// ViolationDryRun means the pod was admitted because the budget is in DryRun mode
+	ViolationDryRun ViolationAction = "DryRun"
+	// ViolationDetected means the namespace went over budget (e.g., after the budget was lowered)
+	ViolationDetected ViolationAction = "Detected"
+)
+
+// BudgetNameLabel is set on each BudgetViolation to the name of the violated budget
+const BudgetNameLabel = "finops.acasa.acme/budget-name"
+
+// ViolatedResource is one exceeded resource with its figures
+type ViolatedResource struct {
+	// Resource exceeded (cpu, memory, monthly_cost, cpu_core_hours or memory_gib_hours)
+	Resource string `json:"resource"`
+
+	// Used is the usage before the pod (or the current usage for controller-detected violations)
+	// +optional
+	Used string `json:"used,omitempty"`
+
+	// Limit is the limit in force
+	// +optional
+	Limit string `json:"limit,omitempty"`
+
+	// Requested is what the pod asked for
+	// +optional
+	Requested string `json:"requested,omitempty"`
+}
+
+// BudgetViolationSpec describes a violation of a budget
+type BudgetViolationSpec struct {
+	// BudgetKind is the kind of the violated budget (ProjectBudget or ClusterBudget)
+	BudgetKind string `json:"budgetKind"`
+
+	// BudgetName is the violated budget (ProjectBudgets live in the operator namespace, ClusterBudgets are cluster-scoped)
+	BudgetName string `json:"budgetName"`
+
+	// Source is what detected the violation
+	Source ViolationSource `json:"source"`
+
+	// Action is what happened to the violation
+	Action ViolationAction `json:"action"`
+
+	// Reason is the reason code of the violation (e.g., "BudgetExceeded")
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the human-readable explanation
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Pod is the name (or generateName prefix) of the pod checked by the webhook
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// User is who created the pod
+	// +optional
+	User string `json:"user,omitempty"`
+
+	// Resources are the exceeded resources with their figures
+	// +optional
+	Resources []ViolatedResource `json:"resources,omitempty"`
+
+	// ExpiresAt is when the record is garbage collected
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=bv
+// +kubebuilder:printcolumn:name="Budget",type=string,JSONPath=`.spec.budgetName`
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.spec.reason`
+// +kubebuilder:printcolumn:name="Pod",type=string,JSONPath=`.spec.pod`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BudgetViolation is the Schema for the budgetviolations API.
+// It records a denied or dry-run admission, or an overrun detected by the controller, in the
+// namespace of the team, and is deleted once spec.expiresAt has passed.
+type BudgetViolation struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec describes the violation
+	// +required
+	Spec BudgetViolationSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// BudgetViolationList contains a list of BudgetViolation
+type BudgetViolationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []BudgetViolation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BudgetViolation{}, &BudgetViolationList{})
+}
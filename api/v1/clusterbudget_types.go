@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterBudgetSpec caps the resources reserved by a group of namespaces
+type ClusterBudgetSpec struct {
+	// +kubebuilder:validation:Required
+	// NamespaceSelector selects the namespaces sharing this budget (e.g., env=sandbox).
+	// An empty selector matches every namespace.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^\d+(m|)$`
+	// MaxCpuLimit is the maximum total CPU allowed across the selected namespaces (e.g., "64")
+	MaxCpuLimit string `json:"maxCpuLimit"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(Mi|Gi)$`
+	// MaxMemoryLimit is the maximum total Memory allowed across the selected namespaces (e.g., "256Gi")
+	MaxMemoryLimit string `json:"maxMemoryLimit,omitempty"`
+
+	// +kubebuilder:validation:Enum=Enforce;DryRun
+	// +kubebuilder:default=Enforce
+	ValidationMode ValidationMode `json:"validationMode,omitempty"`
+}
+
+// ClusterBudgetStatus defines the observed state of ClusterBudget
+type ClusterBudgetStatus struct {
+	// CurrentCpuUsage shows the total CPU limits found in the selected namespaces
+	// +optional
+	CurrentCpuUsage string `json:"currentCpuUsage,omitempty"`
+
+	// CurrentMemoryUsage shows the total Memory limits found in the selected namespaces
+	// +optional
+	CurrentMemoryUsage string `json:"currentMemoryUsage,omitempty"`
+
+	// Namespaces lists the namespaces currently selected
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// LastCheckTime is when the usage was last computed
+	// +optional
+	LastCheckTime string `json:"lastCheckTime,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="CPU Limit",type=string,JSONPath=`.spec.maxCpuLimit`
+// +kubebuilder:printcolumn:name="CPU Used",type=string,JSONPath=`.status.currentCpuUsage`
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.validationMode`
+
+// ClusterBudget is the Schema for the clusterbudgets API
+type ClusterBudget struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of ClusterBudget
+	// +required
+	Spec ClusterBudgetSpec `json:"spec"`
+
+	// status defines the observed state of ClusterBudget
+	// +optional
+	Status ClusterBudgetStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterBudgetList contains a list of ClusterBudget
+type ClusterBudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ClusterBudget `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterBudget{}, &ClusterBudgetList{})
+}
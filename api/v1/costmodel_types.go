@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultCostModelName is the CostModel used by budgets that don't set costModelRef
+const DefaultCostModelName = "default"
+
+// StorageClassPrice is the monthly price of storage provisioned by a StorageClass
+type StorageClassPrice struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Name of the StorageClass (e.g., "gp3")
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
+	// GBMonthPrice is the price of 1 GB for one month (e.g., "0.08")
+	GBMonthPrice string `json:"gbMonthPrice"`
+}
+
+// CostModelSpec defines the prices used to turn resources into money
+type CostModelSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=USD
+	// Currency is only used for display (e.g., "USD", "EUR")
+	Currency string `json:"currency,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
+	// CpuHourPrice is the price of 1 vCPU for one hour (e.g., "0.0316")
+	CpuHourPrice string `json:"cpuHourPrice"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
+	// MemoryGiBHourPrice is the price of 1 GiB of Memory for one hour (e.g., "0.0042")
+	MemoryGiBHourPrice string `json:"memoryGiBHourPrice"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
+	// GpuHourPrice is the price of 1 GPU for one hour (e.g., "2.48")
+	GpuHourPrice string `json:"gpuHourPrice,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="nvidia.com/gpu"
+	// GpuResourceName is the extended resource counted as GPUs
+	GpuResourceName string `json:"gpuResourceName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// StorageClasses lists the monthly price of persistent storage per StorageClass.
+	// Claims of unlisted classes are free.
+	StorageClasses []StorageClassPrice `json:"storageClasses,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Currency",type=string,JSONPath=`.spec.currency`
+// +kubebuilder:printcolumn:name="CPU/h",type=string,JSONPath=`.spec.cpuHourPrice`
+// +kubebuilder:printcolumn:name="GiB/h",type=string,JSONPath=`.spec.memoryGiBHourPrice`
+
+// CostModel is the Schema for the costmodels API
+type CostModel struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the prices of this model
+	// +required
+	Spec CostModelSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// CostModelList contains a list of CostModel
+type CostModelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []CostModel `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CostModel{}, &CostModelList{})
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NotificationChannelType is how a NotificationChannel delivers notifications
+// +kubebuilder:validation:Enum=Webhook;Slack;SMTP
+type NotificationChannelType string
+
+const (
+	// ChannelWebhook POSTs each notification as a JSON document
+	ChannelWebhook NotificationChannelType = "Webhook"
+	// ChannelSlack POSTs a Slack incoming-webhook payload ({"text": "..."})
+	ChannelSlack NotificationChannelType = "Slack"
+	// ChannelSMTP sends each notification as an email
+	ChannelSMTP NotificationChannelType = "SMTP"
+)
+
+// NotificationEvent is something a budget notifies about
+// +kubebuilder:validation:Enum=ThresholdCrossed;PodDenied;Enforcement
+type NotificationEvent string
+
+const (
+	// NotifyThresholdCrossed is sent when utilization rises above one of spec.notifications.thresholds
+	NotifyThresholdCrossed NotificationEvent = "ThresholdCrossed"
+	// NotifyPodDenied summarizes the pods denied (or admitted in DryRun mode) since the last notification
+	NotifyPodDenied NotificationEvent = "PodDenied"
+	// NotifyEnforcement is sent when the controller evicts pods or scales workloads down
+	NotifyEnforcement NotificationEvent = "Enforcement"
+)
+
+// SecretKeyRef selects a key of a Secret in the namespace of the NotificationChannel
+type SecretKeyRef struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Name of the Secret
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Key of the value in the Secret
+	Key string `json:"key"`
+}
+
+// WebhookTarget is the endpoint of a Webhook or Slack channel
+type WebhookTarget struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// URL notifications are POSTed to
+	URL string `json:"url,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// URLSecretRef reads the URL from a Secret instead (Slack webhook URLs are credentials)
+	URLSecretRef *SecretKeyRef `json:"urlSecretRef,omitempty"`
+}
+
+// SMTPTarget is the mail server and recipients of an SMTP channel
+type SMTPTarget struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Host of the mail server (e.g., "smtp.example.com")
+	Host string `json:"host"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=587
+	// Port of the mail server. STARTTLS is used when the server offers it.
+	Port int32 `json:"port,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// From is the sender address (e.g., "finops@example.com")
+	From string `json:"from"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// To are the recipient addresses
+	To []string `json:"to"`
+
+	// +kubebuilder:validation:Optional
+	// CredentialsSecretRef is the name of a Secret with "username" and "password" keys (PLAIN auth).
+	// Mail is sent without authentication when unset.
+	CredentialsSecretRef string `json:"credentialsSecretRef,omitempty"`
+}
+
+// NotificationChannelSpec defines where and what to notify
+type NotificationChannelSpec struct {
+	// +kubebuilder:validation:Required
+	// Type is how notifications are delivered
+	Type NotificationChannelType `json:"type"`
+
+	// +kubebuilder:validation:Optional
+	// Webhook is the endpoint of Webhook and Slack channels
+	Webhook *WebhookTarget `json:"webhook,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// SMTP is the mail server of SMTP channels
+	SMTP *SMTPTarget `json:"smtp,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Events restricts the notifications sent to this channel (all of them when empty)
+	Events []NotificationEvent `json:"events,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=nc
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NotificationChannel is the Schema for the notificationchannels API.
+// ProjectBudgets in the same namespace reference it in spec.notifications.channelRefs.
+// Deliveries that still fail after retrying are reported as DeliveryFailed events on the channel.
+type NotificationChannel struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines where and what to notify
+	// +required
+	Spec NotificationChannelSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationChannelList contains a list of NotificationChannel
+type NotificationChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []NotificationChannel `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NotificationChannel{}, &NotificationChannelList{})
+}
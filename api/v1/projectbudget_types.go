@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -31,6 +32,391 @@ const (
 	DryRunMode ValidationMode = "DryRun"
 )
 
+// EnforcementAction is how the controller brings a namespace back under budget
+// +kubebuilder:validation:Enum=None;ScaleDownWorkloads;EvictNewest;EvictLowestPriority
+type EnforcementAction string
+
+const (
+	// EnforcementNone only reports the violation (default)
+	EnforcementNone EnforcementAction = "None"
+	// EnforcementScaleDownWorkloads lowers the replicas of the Deployments/StatefulSets owning the newest pods
+	EnforcementScaleDownWorkloads EnforcementAction = "ScaleDownWorkloads"
+	// EnforcementEvictNewest evicts the most recently created pods first
+	EnforcementEvictNewest EnforcementAction = "EvictNewest"
+	// EnforcementEvictLowestPriority evicts the pods with the lowest priority first (newest first on ties)
+	EnforcementEvictLowestPriority EnforcementAction = "EvictLowestPriority"
+)
+
+// BillingPeriod is the interval over which cumulative consumption is tracked
+// +kubebuilder:validation:Enum=Monthly;Weekly
+type BillingPeriod string
+
+const (
+	// BillingPeriodMonthly resets consumption on the first day of each month (00:00 UTC)
+	BillingPeriodMonthly BillingPeriod = "Monthly"
+	// BillingPeriodWeekly resets consumption every Monday (00:00 UTC)
+	BillingPeriodWeekly BillingPeriod = "Weekly"
+)
+
+// ForecastModel is how end-of-period consumption is extrapolated from the recorded samples
+// +kubebuilder:validation:Enum=Linear;EWMA
+type ForecastModel string
+
+const (
+	// ForecastLinear fits a straight line (least squares) through the samples of the period
+	ForecastLinear ForecastModel = "Linear"
+	// ForecastEWMA weights recent consumption rates more (exponentially weighted moving average)
+	ForecastEWMA ForecastModel = "EWMA"
+)
+
+// Planned action types reported in ProjectBudgetStatus.EnforcementPlan
+const (
+	// PlannedEvict evicts a pod through the Eviction API (honouring PodDisruptionBudgets)
+	PlannedEvict = "Evict"
+	// PlannedScaleDown lowers the replicas of a workload
+	PlannedScaleDown = "ScaleDown"
+)
+
+// Condition types reported in ProjectBudgetStatus.Conditions
+const (
+	// ConditionBurstExpired is True once the burst window of the current period has been used up
+	ConditionBurstExpired = "BurstExpired"
+	// ConditionOverBudget is True while the namespace uses more than its budget allows
+	ConditionOverBudget = "OverBudget"
+	// ConditionAllowanceExhausted is True once the cumulative allowance of the current billing period is used up
+	ConditionAllowanceExhausted = "AllowanceExhausted"
+	// ConditionProjectedOverrun is True when the forecast says an allowance will be used up before the period ends
+	ConditionProjectedOverrun = "ProjectedOverrun"
+)
+
+// Reasons of the pod denials (metav1.Status.Reason), so deploy tooling can tell why a pod was rejected
+const (
+	// ReasonBudgetExceeded: the pod doesn't fit the CPU/Memory limits of its namespace budget
+	ReasonBudgetExceeded metav1.StatusReason = "BudgetExceeded"
+	// ReasonParentBudgetExceeded: the pod doesn't fit the limits of a parent budget (Details.Name)
+	ReasonParentBudgetExceeded metav1.StatusReason = "ParentBudgetExceeded"
+	// ReasonParentBudgetMissing: a parent of the namespace budget is gone (or the chain loops back)
+	ReasonParentBudgetMissing metav1.StatusReason = "ParentBudgetMissing"
+	// ReasonClusterBudgetExceeded: the pod doesn't fit the limits of a ClusterBudget (Details.Name)
+	ReasonClusterBudgetExceeded metav1.StatusReason = "ClusterBudgetExceeded"
+	// ReasonMonthlyCostExceeded: the pod would take the namespace over its maxMonthlyCost
+	ReasonMonthlyCostExceeded metav1.StatusReason = "MonthlyCostExceeded"
+	// ReasonAllowanceExhausted: the cumulative allowance of the billing period is used up
+	ReasonAllowanceExhausted metav1.StatusReason = "AllowanceExhausted"
+	// ReasonLimitsRequired: some containers have no limits and the budget requires them
+	ReasonLimitsRequired metav1.StatusReason = "LimitsRequired"
+)
+
+// CauseResourceExceeded is the type of the denial causes listing each exceeded resource: the Field is
+// the resource (e.g., "cpu") and the Message its figures (e.g., "used=1500m limit=2 requested=1")
+const CauseResourceExceeded metav1.CauseType = "ResourceExceeded"
+
+// BurstSpec allows a team to temporarily exceed its budget (e.g., during an incident)
+type BurstSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(m|)$`
+	// ExtraCpuLimit is the CPU allowed on top of MaxCpuLimit while bursting (e.g., "500m")
+	ExtraCpuLimit string `json:"extraCpuLimit,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(Mi|Gi)$`
+	// ExtraMemoryLimit is the Memory allowed on top of MaxMemoryLimit while bursting (e.g., "1Gi")
+	ExtraMemoryLimit string `json:"extraMemoryLimit,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// MaxDuration is how long a burst may last in each period (e.g., "2h")
+	MaxDuration metav1.Duration `json:"maxDuration"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="24h"
+	// Period is the interval after which the burst allowance is replenished (e.g., "24h")
+	Period metav1.Duration `json:"period,omitempty"`
+}
+
+// EnforcementSpec configures what happens when a namespace is found over budget
+// (e.g., after the budget was lowered or a burst expired)
+type EnforcementSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=None
+	// Action is how the controller reclaims resources. With validationMode DryRun the plan is only reported.
+	Action EnforcementAction `json:"action,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// GracePeriod is how long the namespace may stay over budget before Action runs (e.g., "30m").
+	// Notices are emitted on the budget and the affected workloads meanwhile. Defaults to acting immediately.
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// PlannedAction is one step of an enforcement plan
+type PlannedAction struct {
+	// Action is either "Evict" or "ScaleDown"
+	Action string `json:"action"`
+
+	// Kind of the target object (Pod, Deployment or StatefulSet)
+	Kind string `json:"kind"`
+
+	// Name of the target object in the team namespace
+	Name string `json:"name"`
+
+	// Replicas is the target replica count of a ScaleDown action
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// CpuReclaimed is the CPU released by this step (e.g., "300m")
+	// +optional
+	CpuReclaimed string `json:"cpuReclaimed,omitempty"`
+
+	// MemoryReclaimed is the Memory released by this step (e.g., "512Mi")
+	// +optional
+	MemoryReclaimed string `json:"memoryReclaimed,omitempty"`
+}
+
+// AccountingSpec tracks consumption over time (e.g., CPU core-hours per month)
+type AccountingSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Monthly
+	// Period is the billing period after which consumption is reset
+	Period BillingPeriod `json:"period,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
+	// MaxCpuCoreHours is the CPU allowance of each period (e.g., "1000" = 1000 cores during one hour)
+	MaxCpuCoreHours string `json:"maxCpuCoreHours,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
+	// MaxMemoryGiBHours is the Memory allowance of each period (e.g., "4000")
+	MaxMemoryGiBHours string `json:"maxMemoryGiBHours,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// BlockWhenExhausted denies new pods once an allowance of the period is used up
+	BlockWhenExhausted bool `json:"blockWhenExhausted,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Linear
+	// ForecastModel extrapolates the consumption at the end of the period to warn before an allowance runs out
+	ForecastModel ForecastModel `json:"forecastModel,omitempty"`
+}
+
+// ConsumptionStatus is the usage integrated over the current billing period
+type ConsumptionStatus struct {
+	// PeriodStart is when the current billing period started
+	PeriodStart metav1.Time `json:"periodStart"`
+
+	// CpuCoreHours consumed in the current period (e.g., "120.500")
+	CpuCoreHours string `json:"cpuCoreHours"`
+
+	// MemoryGiBHours consumed in the current period (e.g., "480.250")
+	MemoryGiBHours string `json:"memoryGiBHours"`
+
+	// CpuMilliCoreSeconds is the exact CPU consumption of the period, rounded in CpuCoreHours
+	// +optional
+	CpuMilliCoreSeconds int64 `json:"cpuMilliCoreSeconds,omitempty"`
+
+	// MemoryMiBSeconds is the exact Memory consumption of the period, rounded in MemoryGiBHours
+	// +optional
+	MemoryMiBSeconds int64 `json:"memoryMiBSeconds,omitempty"`
+
+	// LastSampleTime is when usage was last integrated
+	LastSampleTime metav1.Time `json:"lastSampleTime"`
+
+	// SampledCpuMilli is the CPU reserved at LastSampleTime, accrued until the next sample
+	SampledCpuMilli int64 `json:"sampledCpuMilli"`
+
+	// SampledMemoryBytes is the Memory reserved at LastSampleTime, accrued until the next sample
+	SampledMemoryBytes int64 `json:"sampledMemoryBytes"`
+
+	// Samples are hourly snapshots of the totals in the current period, used for forecasting
+	// +optional
+	Samples []ConsumptionSample `json:"samples,omitempty"`
+
+	// ProjectedCpuCoreHours is the CPU consumption forecast for the end of the period
+	// +optional
+	ProjectedCpuCoreHours string `json:"projectedCpuCoreHours,omitempty"`
+
+	// ProjectedMemoryGiBHours is the Memory consumption forecast for the end of the period
+	// +optional
+	ProjectedMemoryGiBHours string `json:"projectedMemoryGiBHours,omitempty"`
+}
+
+// ConsumptionSample is a snapshot of the consumption totals of the period
+type ConsumptionSample struct {
+	// Time of the snapshot
+	Time metav1.Time `json:"time"`
+
+	// CpuCoreHours consumed since the period started
+	CpuCoreHours string `json:"cpuCoreHours"`
+
+	// MemoryGiBHours consumed since the period started
+	MemoryGiBHours string `json:"memoryGiBHours"`
+}
+
+// PeriodConsumption is the consumption of a closed billing period
+type PeriodConsumption struct {
+	// Start of the period
+	Start metav1.Time `json:"start"`
+
+	// End of the period
+	End metav1.Time `json:"end"`
+
+	// CpuCoreHours consumed during the period
+	CpuCoreHours string `json:"cpuCoreHours"`
+
+	// MemoryGiBHours consumed during the period
+	MemoryGiBHours string `json:"memoryGiBHours"`
+}
+
+// BudgetReference points to another ProjectBudget
+type BudgetReference struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Name of the ProjectBudget
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// Namespace of the ProjectBudget (defaults to the namespace of the referencing budget)
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// LendingSpec lets a team lend its unused headroom to other teams
+type LendingSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// Borrowers are the budgets allowed to borrow from this one, usually its siblings
+	Borrowers []BudgetReference `json:"borrowers"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^\d+(m|)$`
+	// MaxLentCpu caps the CPU lent to all borrowers at once (e.g., "1000m")
+	MaxLentCpu string `json:"maxLentCpu"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(Mi|Gi)$`
+	// MaxLentMemory caps the Memory lent to all borrowers at once (e.g., "2Gi"). No Memory is lent if unset.
+	MaxLentMemory string `json:"maxLentMemory,omitempty"`
+}
+
+// Loan is headroom lent by one budget to another
+type Loan struct {
+	// Budget is the other party: the borrower in lentTo, the lender in borrowedFrom
+	Budget BudgetReference `json:"budget"`
+
+	// Cpu lent (e.g., "500m")
+	// +optional
+	Cpu string `json:"cpu,omitempty"`
+
+	// Memory lent (e.g., "1Gi")
+	// +optional
+	Memory string `json:"memory,omitempty"`
+}
+
+// ResourceQuotaStatus is the state of the ResourceQuota mirroring a budget
+type ResourceQuotaStatus struct {
+	// Name of the ResourceQuota in the team namespace
+	Name string `json:"name"`
+
+	// Hard is the limits enforced by the quota
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+
+	// Used is the usage tracked by the quota controller
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
+}
+
+// RecommendationSpec enables right-sizing recommendations (requires usage metrics)
+type RecommendationSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="24h"
+	// Window is how much observed usage the p95 is computed over (e.g., "168h")
+	Window metav1.Duration `json:"window,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50
+	// +kubebuilder:default=5
+	// TopN is how many of the most over-provisioned workloads are published
+	TopN int32 `json:"topN,omitempty"`
+}
+
+// BudgetWindow replaces the budget limits while its schedule is active (e.g., business hours)
+type BudgetWindow struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Name identifies the window in status and events (e.g., "business-hours")
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Schedule is a cron expression marking when the window opens (e.g., "0 8 * * 1-5").
+	// Use the "CRON_TZ=Europe/Madrid 0 8 * * 1-5" form to pin a time zone (UTC otherwise).
+	Schedule string `json:"schedule"`
+
+	// +kubebuilder:validation:Required
+	// Duration is how long the window stays open after each activation (e.g., "10h")
+	Duration metav1.Duration `json:"duration"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^\d+(m|)$`
+	// MaxCpuLimit replaces spec.maxCpuLimit while the window is open
+	MaxCpuLimit string `json:"maxCpuLimit"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(Mi|Gi)$`
+	// MaxMemoryLimit replaces spec.maxMemoryLimit while the window is open (defaults to spec.maxMemoryLimit)
+	MaxMemoryLimit string `json:"maxMemoryLimit,omitempty"`
+}
+
+// ContainerDefaults are injected into containers that omit their CPU/Memory requests or limits
+type ContainerDefaults struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(m|)$`
+	// CpuLimit is set on containers without a CPU limit (e.g., "500m")
+	CpuLimit string `json:"cpuLimit,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(Mi|Gi)$`
+	// MemoryLimit is set on containers without a Memory limit (e.g., "512Mi")
+	MemoryLimit string `json:"memoryLimit,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(m|)$`
+	// CpuRequest is set on containers without a CPU request (e.g., "100m")
+	CpuRequest string `json:"cpuRequest,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(Mi|Gi)$`
+	// MemoryRequest is set on containers without a Memory request (e.g., "128Mi")
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// RequireLimits denies pods with containers still missing a CPU limit (or a Memory limit when
+	// the budget caps Memory) after defaulting, since those would count as zero against the budget
+	RequireLimits bool `json:"requireLimits,omitempty"`
+}
+
+// NotificationSpec sends the notifications of a budget to NotificationChannels
+type NotificationSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// ChannelRefs are the names of NotificationChannels in the namespace of the budget
+	ChannelRefs []string `json:"channelRefs"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={80,100}
+	// +kubebuilder:validation:items:Minimum=1
+	// Thresholds are utilization percentages (of the CPU or Memory limit in force) announced once
+	// each time usage rises above them. They are announced again after usage drops below.
+	Thresholds []int32 `json:"thresholds,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="15m"
+	// DenialDebounce is the minimum interval between two PodDenied notifications; the denials in
+	// between are summarized in the next one. Denials are read from the BudgetViolations recorded
+	// by the webhook, so they require --violation-ttl to be greater than 0.
+	DenialDebounce metav1.Duration `json:"denialDebounce,omitempty"`
+}
+
 // ProjectBudgetSpec defines the desired state of ProjectBudget
 type ProjectBudgetSpec struct {
 	// +kubebuilder:validation:Required
@@ -51,6 +437,66 @@ type ProjectBudgetSpec struct {
 	// +kubebuilder:validation:Enum=Enforce;DryRun
 	// +kubebuilder:default=Enforce
 	ValidationMode ValidationMode `json:"validationMode,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// ParentRef rolls this budget up into a parent budget (e.g., team -> department -> organization).
+	// The limits of all children may not exceed the parent, and new pods must fit in every ancestor.
+	ParentRef *BudgetReference `json:"parentRef,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Burst allows exceeding the budget for a limited time per period
+	Burst *BurstSpec `json:"burst,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Schedules are alternative limits applied on a cron schedule. The first open window wins;
+	// MaxCpuLimit/MaxMemoryLimit apply when none is open.
+	Schedules []BudgetWindow `json:"schedules,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
+	// MaxMonthlyCost is the maximum projected monthly cost of the namespace (e.g., "1500"),
+	// in the currency of the referenced CostModel
+	MaxMonthlyCost string `json:"maxMonthlyCost,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// CostModelRef is the name of the cluster-scoped CostModel used to price resources (defaults to "default")
+	CostModelRef string `json:"costModelRef,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Enforcement reclaims resources when the namespace is over budget (opt-in)
+	Enforcement *EnforcementSpec `json:"enforcement,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Accounting tracks cumulative consumption per billing period, with optional allowances
+	Accounting *AccountingSpec `json:"accounting,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Recommendations publishes right-sizing suggestions for over-provisioned workloads
+	// (in status and as a BudgetRecommendation). Requires the manager to read usage metrics.
+	Recommendations *RecommendationSpec `json:"recommendations,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Lending lets the listed budgets use the unused headroom of this one when they run out.
+	// Loans are reclaimed (through the borrower's enforcement) as soon as this team needs the capacity back.
+	Lending *LendingSpec `json:"lending,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Defaults are injected into containers without requests/limits, so they can't bypass the budget.
+	// Values not set here fall back to the Container defaults of the namespace's LimitRanges.
+	Defaults *ContainerDefaults `json:"defaults,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// SyncResourceQuota mirrors the budget into a native ResourceQuota in the team namespace
+	// (limits.cpu and limits.memory), for scheduler-level accounting as a second line of defense.
+	// The quota is kept in sync (manual edits are reverted) and its usage is reported in status.
+	// Budgets in DryRun mode are not mirrored, as the quota would enforce their limits.
+	SyncResourceQuota bool `json:"syncResourceQuota,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Notifications delivers threshold crossings, denials and enforcement actions to NotificationChannels
+	Notifications *NotificationSpec `json:"notifications,omitempty"`
 }
 
 // ProjectBudgetStatus defines the observed state of ProjectBudget.
@@ -58,8 +504,105 @@ type ProjectBudgetStatus struct {
 	// CurrentCpuUsage shows the total CPU requests found in the namespace
 	CurrentCpuUsage string `json:"currentCpuUsage,omitempty"`
 
+	// CurrentMemoryUsage shows the total Memory limits found in the namespace
+	// +optional
+	CurrentMemoryUsage string `json:"currentMemoryUsage,omitempty"`
+
+	// AggregatedCpuUsage is the CPU limits of the namespaces of this budget and all its descendants
+	// (only set on budgets with children)
+	// +optional
+	AggregatedCpuUsage string `json:"aggregatedCpuUsage,omitempty"`
+
+	// AggregatedMemoryUsage is the Memory limits of the namespaces of this budget and all its descendants
+	// +optional
+	AggregatedMemoryUsage string `json:"aggregatedMemoryUsage,omitempty"`
+
+	// ObservedCpuUsage is the CPU actually used by the pods according to metrics.k8s.io
+	// (empty when usage metrics are disabled or unavailable)
+	// +optional
+	ObservedCpuUsage string `json:"observedCpuUsage,omitempty"`
+
+	// ObservedMemoryUsage is the Memory actually used by the pods according to metrics.k8s.io
+	// +optional
+	ObservedMemoryUsage string `json:"observedMemoryUsage,omitempty"`
+
+	// CurrentMonthlyCost is the run-rate cost of the namespace projected over a month (e.g., "412.50")
+	// +optional
+	CurrentMonthlyCost string `json:"currentMonthlyCost,omitempty"`
+
 	// LastCheckTime is the timestamp of the last reconciliation
 	LastCheckTime string `json:"lastCheckTime,omitempty"`
+
+	// ActiveWindow is the name of the schedule currently overriding the limits (empty for the defaults)
+	// +optional
+	ActiveWindow string `json:"activeWindow,omitempty"`
+
+	// BurstStartTime is when the namespace first exceeded its budget in the current burst period
+	// +optional
+	BurstStartTime *metav1.Time `json:"burstStartTime,omitempty"`
+
+	// EnforcementPlan lists the steps computed to bring the namespace back under budget.
+	// It is written before any step is executed (and never executed in DryRun mode).
+	// +optional
+	EnforcementPlan []PlannedAction `json:"enforcementPlan,omitempty"`
+
+	// OverBudgetSince is when the namespace was first found over budget (cleared once back under)
+	// +optional
+	OverBudgetSince *metav1.Time `json:"overBudgetSince,omitempty"`
+
+	// EnforcementDeadline is when the enforcement action runs if usage has not come back under the limit
+	// +optional
+	EnforcementDeadline *metav1.Time `json:"enforcementDeadline,omitempty"`
+
+	// LastEnforcementTime is when the controller last executed an enforcement plan
+	// +optional
+	LastEnforcementTime *metav1.Time `json:"lastEnforcementTime,omitempty"`
+
+	// Consumption is the usage accumulated in the current billing period (requires spec.accounting)
+	// +optional
+	Consumption *ConsumptionStatus `json:"consumption,omitempty"`
+
+	// ConsumptionHistory keeps the totals of the last closed billing periods (oldest first)
+	// +optional
+	ConsumptionHistory []PeriodConsumption `json:"consumptionHistory,omitempty"`
+
+	// Recommendations are the most over-provisioned workloads, largest CPU savings first
+	// +optional
+	Recommendations []WorkloadRecommendation `json:"recommendations,omitempty"`
+
+	// PotentialCpuSavings is the CPU freed by applying every recommendation (e.g., "6000m")
+	// +optional
+	PotentialCpuSavings string `json:"potentialCpuSavings,omitempty"`
+
+	// PotentialMemorySavings is the Memory freed by applying every recommendation (e.g., "8Gi")
+	// +optional
+	PotentialMemorySavings string `json:"potentialMemorySavings,omitempty"`
+
+	// LentTo lists the headroom of this budget currently used by borrowers
+	// +optional
+	LentTo []Loan `json:"lentTo,omitempty"`
+
+	// BorrowedFrom lists the headroom of lenders currently used by this budget
+	// +optional
+	BorrowedFrom []Loan `json:"borrowedFrom,omitempty"`
+
+	// ResourceQuota reports the native quota mirroring this budget (when syncResourceQuota is set)
+	// +optional
+	ResourceQuota *ResourceQuotaStatus `json:"resourceQuota,omitempty"`
+
+	// NotifiedThreshold is the highest utilization threshold announced since usage was last below it
+	// +optional
+	NotifiedThreshold int32 `json:"notifiedThreshold,omitempty"`
+
+	// LastDenialNotificationTime is when denied pods were last notified
+	// +optional
+	LastDenialNotificationTime *metav1.Time `json:"lastDenialNotificationTime,omitempty"`
+
+	// Conditions represent the latest available observations of the budget state
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// budget-import prints DryRun ProjectBudget manifests equivalent to the ResourceQuotas of a
+// cluster (or of YAML files), and reports on stderr what has no equivalent.
+//
+//	budget-import --kubeconfig ~/.kube/config --budget-namespace finops > budgets.yaml
+//	budget-import -f quotas.yaml -f limitranges.yaml
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/importer"
+)
+
+// fileList collects repeated -f flags.
+type fileList []string
+
+func (f *fileList) String() string { return strings.Join(*f, ",") }
+
+func (f *fileList) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
+
+func main() {
+	var files fileList
+	var namespace, budgetNamespace string
+	flag.Var(&files, "f", "ResourceQuota/LimitRange manifests to read instead of the cluster (repeatable, - for stdin).")
+	flag.StringVar(&namespace, "namespace", "", "Only import this namespace (all namespaces by default).")
+	flag.StringVar(&budgetNamespace, "budget-namespace", "",
+		"Namespace of the generated budgets. Defaults to the namespace each budget governs.")
+	flag.Parse()
+
+	quotas, limitRanges, err := load(files, namespace)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "budget-import: %v\n", err)
+		os.Exit(1)
+	}
+
+	results := importer.Import(quotas, limitRanges, importer.Options{BudgetNamespace: budgetNamespace})
+	if err := importer.WriteManifests(os.Stdout, results); err != nil {
+		fmt.Fprintf(os.Stderr, "budget-import: %v\n", err)
+		os.Exit(1)
+	}
+	if err := importer.WriteReport(os.Stderr, results); err != nil {
+		os.Exit(1)
+	}
+}
+
+// load reads the quotas and limit ranges from files, or from the cluster when none is given.
+func load(files []string, namespace string) ([]corev1.ResourceQuota, []corev1.LimitRange, error) {
+	var quotas []corev1.ResourceQuota
+	var limitRanges []corev1.LimitRange
+
+	if len(files) == 0 {
+		return fromCluster(namespace)
+	}
+	for _, name := range files {
+		q, lr, err := decodeFile(name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", name, err)
+		}
+		quotas, limitRanges = append(quotas, q...), append(limitRanges, lr...)
+	}
+
+	if namespace != "" {
+		quotas = filterNamespace(quotas, namespace, func(q corev1.ResourceQuota) string { return q.Namespace })
+		limitRanges = filterNamespace(limitRanges, namespace, func(lr corev1.LimitRange) string { return lr.Namespace })
+	}
+	return quotas, limitRanges, nil
+}
+
+// decodeFile reads the manifests of one file ("-" is stdin).
+func decodeFile(name string) ([]corev1.ResourceQuota, []corev1.LimitRange, error) {
+	if name == "-" {
+		return importer.Decode(os.Stdin)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return importer.Decode(f)
+}
+
+// fromCluster lists the quotas and limit ranges with the kubeconfig (--kubeconfig or KUBECONFIG).
+func fromCluster(namespace string) ([]corev1.ResourceQuota, []corev1.LimitRange, error) {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, nil, err
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx := context.Background()
+	var quotas corev1.ResourceQuotaList
+	if err := c.List(ctx, &quotas, client.InNamespace(namespace)); err != nil {
+		return nil, nil, err
+	}
+	var limitRanges corev1.LimitRangeList
+	if err := c.List(ctx, &limitRanges, client.InNamespace(namespace)); err != nil {
+		return nil, nil, err
+	}
+	return quotas.Items, limitRanges.Items, nil
+}
+
+// filterNamespace keeps the items of the given namespace.
+func filterNamespace[T any](items []T, namespace string, namespaceOf func(T) string) []T {
+	var kept []T
+	for _, item := range items {
+		if namespaceOf(item) == namespace {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
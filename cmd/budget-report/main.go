@@ -0,0 +1,151 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// budget-report prints the consumption and cost recorded by the ProjectBudgets of a cluster
+// over a time range (the current month to date by default), as CSV, JSON or Markdown.
+//
+//	budget-report --month 2026-03 --group-by cost-center --format csv > march.csv
+//	budget-report --from 2026-03-01 --to 2026-03-15 --namespace finops --format markdown
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/report"
+)
+
+func main() {
+	var month, from, to, groupBy, format, namespace, selector string
+	flag.StringVar(&month, "month", "", "Report this calendar month (YYYY-MM, UTC) instead of --from/--to.")
+	flag.StringVar(&from, "from", "", "Start of the range (YYYY-MM-DD or RFC3339). Defaults to the start of the current month.")
+	flag.StringVar(&to, "to", "", "End of the range, excluded (YYYY-MM-DD or RFC3339). Defaults to now.")
+	flag.StringVar(&groupBy, "group-by", "", "Comma-separated namespace labels to total the budgets by (e.g., cost-center).")
+	flag.StringVar(&format, "format", string(report.FormatMarkdown), "Output format: csv, json or markdown.")
+	flag.StringVar(&namespace, "namespace", "", "Only report the ProjectBudgets of this namespace (all namespaces by default).")
+	flag.StringVar(&selector, "selector", "", "Only report the ProjectBudgets matching this label selector.")
+	flag.Parse()
+
+	if err := run(month, from, to, groupBy, report.Format(format), namespace, selector); err != nil {
+		fmt.Fprintf(os.Stderr, "budget-report: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run builds the report from the cluster (--kubeconfig or KUBECONFIG) and prints it.
+func run(month, from, to, groupBy string, format report.Format, namespace, selector string) error {
+	if !slices.Contains(report.Formats, format) {
+		return fmt.Errorf("unknown format %q (expected csv, json or markdown)", format)
+	}
+	now := time.Now().UTC()
+	start, end, err := parseRange(month, from, to, now)
+	if err != nil {
+		return err
+	}
+	budgetSelector, err := labels.Parse(selector)
+	if err != nil {
+		return fmt.Errorf("invalid --selector: %w", err)
+	}
+	var keys []string
+	if groupBy != "" {
+		keys = strings.Split(groupBy, ",")
+	}
+
+	c, err := newClient()
+	if err != nil {
+		return err
+	}
+	budgets, err := report.Load(context.Background(), c, namespace, budgetSelector)
+	if err != nil {
+		return err
+	}
+	showback, err := report.Build(budgets, report.Options{Start: start, End: end, GroupBy: keys, Now: now})
+	if err != nil {
+		return err
+	}
+	return report.Write(os.Stdout, format, showback)
+}
+
+// parseRange resolves the reported range from --month or --from/--to.
+func parseRange(month, from, to string, now time.Time) (time.Time, time.Time, error) {
+	if month != "" {
+		if from != "" || to != "" {
+			return time.Time{}, time.Time{}, errors.New("--month cannot be combined with --from/--to")
+		}
+		start, err := time.Parse("2006-01", month)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --month %q (expected YYYY-MM)", month)
+		}
+		return start, start.AddDate(0, 1, 0), nil
+	}
+
+	start, end := report.MonthStart(now), now
+	var err error
+	if from != "" {
+		if start, err = parseTime(from); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --from: %w", err)
+		}
+	}
+	if to != "" {
+		if end, err = parseTime(to); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --to: %w", err)
+		}
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("empty range: %s is not before %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+	return start, end, nil
+}
+
+// parseTime reads a date (midnight UTC) or an RFC3339 timestamp.
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// newClient connects to the cluster with the kubeconfig.
+func newClient() (client.Client, error) {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := finopsv1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{Scheme: scheme})
+}
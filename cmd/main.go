@@ -17,9 +17,11 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -28,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -36,7 +39,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/audit"
 	"github.com/AlejandroCasa/k8s-governance-operator/internal/controller"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/notify"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/tracing"
 	webhookv1 "github.com/AlejandroCasa/k8s-governance-operator/internal/webhook/v1"
 	// +kubebuilder:scaffold:imports
 )
@@ -62,6 +68,8 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var enableUsageMetrics bool
+	var violationTTL time.Duration
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -80,14 +88,46 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&enableUsageMetrics, "enable-usage-metrics", false,
+		"If set, actual pod usage is read from metrics.k8s.io (requires metrics-server) and reported next to limits")
+	flag.DurationVar(&violationTTL, "violation-ttl", webhookv1.DefaultViolationTTL,
+		"How long BudgetViolation records are kept before being garbage collected. Use 0 to not record violations.")
 	opts := zap.Options{
 		Development: true,
 	}
 	opts.BindFlags(flag.CommandLine)
+	var tracingOpts tracing.Options
+	tracingOpts.BindFlags(flag.CommandLine)
+	var auditOpts audit.Options
+	auditOpts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	// Tracing stays a no-op unless --otlp-endpoint is set
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingOpts)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "failed to flush traces")
+		}
+	}()
+
+	// Admission decisions are only audited if --audit-sink is set
+	auditSink, closeAudit, err := audit.New(auditOpts)
+	if err != nil {
+		setupLog.Error(err, "unable to set up the audit sink")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := closeAudit(); err != nil {
+			setupLog.Error(err, "failed to flush audit records")
+		}
+	}()
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -179,20 +219,66 @@ func main() {
 		os.Exit(1)
 	}
 
+	var metricsClient metricsclientset.Interface
+	if enableUsageMetrics {
+		metricsClient, err = metricsclientset.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			setupLog.Error(err, "unable to create metrics.k8s.io client")
+			os.Exit(1)
+		}
+	}
+
+	// Budget notifications are delivered in the background; channel Secrets are read uncached
+	notifier := notify.NewDispatcher(mgr.GetAPIReader(), mgr.GetEventRecorderFor("notification-dispatcher"))
+	if err := mgr.Add(notifier); err != nil {
+		setupLog.Error(err, "unable to add notification dispatcher")
+		os.Exit(1)
+	}
+
 	if err := (&controller.ProjectBudgetReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Recorder:      mgr.GetEventRecorderFor("projectbudget-controller"),
+		MetricsClient: metricsClient,
+		ViolationTTL:  violationTTL,
+		Notifier:      notifier,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ProjectBudget")
+		os.Exit(1)
+	}
+	if err := (&controller.ClusterBudgetReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("clusterbudget-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterBudget")
+		os.Exit(1)
+	}
+	if err := (&controller.BudgetViolationReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "ProjectBudget")
+		setupLog.Error(err, "unable to create controller", "controller", "BudgetViolation")
+		os.Exit(1)
+	}
+	if err := (&controller.BudgetReportReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BudgetReport")
 		os.Exit(1)
 	}
 	// nolint:goconst
 	setupLog.Info("Starting WEBHOOKS v0.11.0 - NO CACHE")
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
-		if err := webhookv1.SetupPodWebhookWithManager(mgr); err != nil {
+		if err := webhookv1.SetupPodWebhookWithManager(mgr, auditSink, violationTTL); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Pod")
 			os.Exit(1)
 		}
+		if err := webhookv1.SetupProjectBudgetWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ProjectBudget")
+			os.Exit(1)
+		}
 	}
 	// +kubebuilder:scaffold:builder
 
@@ -208,6 +294,8 @@ func main() {
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
+		_ = shutdownTracing(context.Background())
+		_ = closeAudit()
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit writes every pod admission decision as a JSON line to a sink (stdout, a
+// rotating file or an HTTP endpoint), so decisions can be audited long after their events expired.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// Record is one admission decision.
+type Record struct {
+	Time time.Time `json:"time"`
+	// Decision is the outcome (allowed, denied, dry_run, exempt, error_fail_open or resized)
+	Decision string `json:"decision"`
+	// User is who created the pod (often a controller's service account)
+	User      string `json:"user,omitempty"`
+	Namespace string `json:"namespace"`
+	// Pod is the pod name, or its generateName prefix when the name is not assigned yet
+	Pod string `json:"pod"`
+	// Budget is the budget the decision was taken against (the exceeded one on violations)
+	Budget string `json:"budget,omitempty"`
+	// Reason is the reason code of a violation (e.g., "BudgetExceeded")
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Request is what the pod reserves (the sum of its container limits)
+	Request Resources `json:"request"`
+	// Used is the usage of the namespace before the pod, and Limit the limits in force of its budget
+	Used  *Resources `json:"used,omitempty"`
+	Limit *Resources `json:"limit,omitempty"`
+	// Causes lists each exceeded resource with its figures, as in the denial
+	Causes []metav1.StatusCause `json:"causes,omitempty"`
+}
+
+// Resources are CPU and Memory figures as Kubernetes quantities (e.g., "500m", "1Gi").
+type Resources struct {
+	CPU    string `json:"cpu"`
+	Memory string `json:"memory"`
+}
+
+// Figures formats budget resources for a record.
+func Figures(r budget.Resources) Resources {
+	return Resources{
+		CPU:    resource.NewMilliQuantity(r.CPUMilli, resource.DecimalSI).String(),
+		Memory: resource.NewQuantity(r.MemoryBytes, resource.BinarySI).String(),
+	}
+}
+
+// Sink receives the audit records. Implementations are safe for concurrent use.
+type Sink interface {
+	Write(ctx context.Context, rec Record) error
+}
+
+// writerSink encodes the records as JSON lines on a writer.
+type writerSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink writes one JSON line per record to w.
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+func (s *writerSink) Write(_ context.Context, rec Record) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
+
+// Options configures the audit sink.
+type Options struct {
+	// Sink is "stdout", a file ("file:///var/log/finops/audit.jsonl") or an http(s) URL. Auditing is disabled when empty.
+	Sink string
+	// MaxSizeMB is the size at which the audit file is rotated
+	MaxSizeMB int
+	// MaxBackups is the number of rotated audit files kept
+	MaxBackups int
+}
+
+// BindFlags registers the audit flags on fs.
+func (o *Options) BindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Sink, "audit-sink", "",
+		"Where admission decisions are written as JSON lines: stdout, file:///path/to/audit.jsonl or an http(s) URL. "+
+			"Auditing is disabled if empty.")
+	fs.IntVar(&o.MaxSizeMB, "audit-file-max-size-mb", 100, "Size in MiB at which the audit file is rotated.")
+	fs.IntVar(&o.MaxBackups, "audit-file-max-backups", 5, "Number of rotated audit files kept.")
+}
+
+// New opens the configured sink and returns the function flushing and closing it on exit.
+// Without a sink it returns nil (auditing disabled).
+func New(opts Options) (Sink, func() error, error) {
+	noop := func() error { return nil }
+	switch {
+	case opts.Sink == "":
+		return nil, noop, nil
+	case opts.Sink == "stdout":
+		return NewWriterSink(os.Stdout), noop, nil
+	case strings.HasPrefix(opts.Sink, "file://"):
+		u, err := url.Parse(opts.Sink)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid audit sink %q: %w", opts.Sink, err)
+		}
+		f, err := OpenRotatingFile(u.Path, int64(opts.MaxSizeMB)<<20, opts.MaxBackups)
+		if err != nil {
+			return nil, nil, err
+		}
+		return NewWriterSink(f), f.Close, nil
+	case strings.HasPrefix(opts.Sink, "http://"), strings.HasPrefix(opts.Sink, "https://"):
+		s := NewHTTPSink(opts.Sink, nil)
+		return s, s.Close, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported audit sink %q (expected stdout, file:// or http(s)://)", opts.Sink)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+var _ = Describe("Audit sinks", func() {
+	rec := Record{
+		Time:      time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC),
+		Decision:  "denied",
+		User:      "system:serviceaccount:kube-system:replicaset-controller",
+		Namespace: "team-a",
+		Pod:       "api-7d9f-",
+		Budget:    "team-a-budget",
+		Request:   Figures(budget.Resources{CPUMilli: 500, MemoryBytes: 1 << 30}),
+	}
+
+	It("should write one JSON line per record", func() {
+		var out bytes.Buffer
+		sink := NewWriterSink(&out)
+		Expect(sink.Write(context.Background(), rec)).To(Succeed())
+		Expect(sink.Write(context.Background(), rec)).To(Succeed())
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(2))
+		var decoded map[string]any
+		Expect(json.Unmarshal([]byte(lines[0]), &decoded)).To(Succeed())
+		Expect(decoded).To(HaveKeyWithValue("decision", "denied"))
+		Expect(decoded).To(HaveKeyWithValue("pod", "api-7d9f-"))
+		Expect(decoded).To(HaveKeyWithValue("request", map[string]any{"cpu": "500m", "memory": "1Gi"}))
+		Expect(decoded).NotTo(HaveKey("used"))
+	})
+
+	It("should rotate the file and keep only the newest backups", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "audit.jsonl")
+		f, err := OpenRotatingFile(path, 10, 2)
+		Expect(err).NotTo(HaveOccurred())
+		for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+			_, err := f.Write([]byte(line))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(f.Close()).To(Succeed())
+
+		read := func(p string) string {
+			data, err := os.ReadFile(p)
+			Expect(err).NotTo(HaveOccurred())
+			return string(data)
+		}
+		Expect(read(path)).To(Equal("fourth\n"))
+		Expect(read(path + ".1")).To(Equal("third\n"))
+		Expect(read(path + ".2")).To(Equal("second\n"))
+		Expect(path + ".3").NotTo(BeAnExistingFile())
+	})
+
+	It("should POST the records as JSON lines and flush them on close", func() {
+		var (
+			mu       sync.Mutex
+			received []Record
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/x-ndjson"))
+			body, err := io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			scanner := bufio.NewScanner(bytes.NewReader(body))
+			mu.Lock()
+			defer mu.Unlock()
+			for scanner.Scan() {
+				var got Record
+				Expect(json.Unmarshal(scanner.Bytes(), &got)).To(Succeed())
+				received = append(received, got)
+			}
+		}))
+		defer server.Close()
+
+		sink := NewHTTPSink(server.URL, server.Client())
+		for range 3 {
+			Expect(sink.Write(context.Background(), rec)).To(Succeed())
+		}
+		Expect(sink.Close()).To(Succeed())
+
+		mu.Lock()
+		defer mu.Unlock()
+		Expect(received).To(HaveLen(3))
+		Expect(received[0].Budget).To(Equal("team-a-budget"))
+	})
+
+	It("should retry a batch while the endpoint recovers, then drop and count it", func() {
+		var (
+			mu       sync.Mutex
+			failures int
+			received int
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			if failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			received++
+		}))
+		defer server.Close()
+		failed := testutil.ToFloat64(droppedRecords.WithLabelValues(dropSendFailed))
+
+		By("recovering within the retries")
+		failures = httpMaxAttempts - 1
+		sink := NewHTTPSink(server.URL, server.Client())
+		sink.backoff = time.Millisecond
+		Expect(sink.Write(context.Background(), rec)).To(Succeed())
+		Expect(sink.Close()).To(Succeed())
+		Expect(received).To(Equal(1))
+		Expect(testutil.ToFloat64(droppedRecords.WithLabelValues(dropSendFailed))).To(Equal(failed))
+
+		By("staying down longer than the retries")
+		failures = httpMaxAttempts
+		sink = NewHTTPSink(server.URL, server.Client())
+		sink.backoff = time.Millisecond
+		Expect(sink.Write(context.Background(), rec)).To(Succeed())
+		Expect(sink.Close()).To(Succeed())
+		Expect(received).To(Equal(1))
+		Expect(testutil.ToFloat64(droppedRecords.WithLabelValues(dropSendFailed))).To(Equal(failed + 1))
+	})
+
+	It("should pick the sink from its configuration", func() {
+		sink, closeSink, err := New(Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sink).To(BeNil())
+		Expect(closeSink()).To(Succeed())
+
+		path := filepath.Join(GinkgoT().TempDir(), "audit.jsonl")
+		sink, closeSink, err = New(Options{Sink: "file://" + path, MaxSizeMB: 1, MaxBackups: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sink.Write(context.Background(), rec)).To(Succeed())
+		Expect(closeSink()).To(Succeed())
+		Expect(path).To(BeAnExistingFile())
+
+		_, _, err = New(Options{Sink: "kafka://audit"})
+		Expect(err).To(MatchError(ContainSubstring("unsupported audit sink")))
+	})
+})
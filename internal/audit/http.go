@@ -0,0 +1,175 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// httpQueueSize is the number of records buffered while the endpoint is slow or down.
+const httpQueueSize = 1000
+
+// httpBatchSize is the maximum number of records POSTed at once.
+const httpBatchSize = 100
+
+// httpMaxAttempts is the number of times a batch is POSTed before it is dropped.
+const httpMaxAttempts = 5
+
+// httpRetryBackoff is the wait before the first retry of a batch, doubled on each new attempt.
+const httpRetryBackoff = time.Second
+
+// Values of the reason label of the dropped records counter
+const (
+	dropQueueFull  = "queue_full"
+	dropSendFailed = "send_failed"
+)
+
+// droppedRecords counts the audit records lost by the HTTP sink
+var droppedRecords = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "finops_audit_dropped_records_total",
+		Help: "Total number of audit records dropped by the HTTP sink, by reason (queue_full, send_failed)",
+	},
+	[]string{"reason"},
+)
+
+func init() {
+	// Register the metrics in the global registry of controller-runtime
+	metrics.Registry.MustRegister(droppedRecords)
+}
+
+// ErrQueueFull is returned when records arrive faster than the endpoint takes them (the record is dropped).
+var ErrQueueFull = errors.New("audit queue is full, record dropped")
+
+var httpLog = logf.Log.WithName("audit-http")
+
+// HTTPSink POSTs the records in the background as JSON lines (application/x-ndjson), so a
+// slow endpoint never delays admissions. Delivery is best effort: a batch is retried with
+// exponential backoff on network errors, 5xx and 429 responses (so it may arrive twice), then dropped. Records arriving while the queue is full are dropped too. Every dropped
+// record is logged and counted in finops_audit_dropped_records_total.
+type HTTPSink struct {
+	endpoint string
+	client   *http.Client
+	queue    chan Record
+	done     chan struct{}
+	// backoff is the wait before the first retry (httpRetryBackoff)
+	backoff time.Duration
+}
+
+// NewHTTPSink starts sending records to endpoint with client (a 10s timeout client when nil).
+func NewHTTPSink(endpoint string, client *http.Client) *HTTPSink {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	s := &HTTPSink{
+		endpoint: endpoint,
+		client:   client,
+		queue:    make(chan Record, httpQueueSize),
+		done:     make(chan struct{}),
+		backoff:  httpRetryBackoff,
+	}
+	go s.run()
+	return s
+}
+
+// Write queues the record without waiting for the endpoint.
+func (s *HTTPSink) Write(_ context.Context, rec Record) error {
+	select {
+	case s.queue <- rec:
+		return nil
+	default:
+		droppedRecords.WithLabelValues(dropQueueFull).Inc()
+		return ErrQueueFull
+	}
+}
+
+// Close sends the queued records and stops the sink. Write must not be called afterwards.
+func (s *HTTPSink) Close() error {
+	close(s.queue)
+	<-s.done
+	return nil
+}
+
+// run sends the queued records, batching those already waiting.
+func (s *HTTPSink) run() {
+	defer close(s.done)
+	for rec := range s.queue {
+		batch := []Record{rec}
+	drain:
+		for len(batch) < httpBatchSize {
+			select {
+			case next, ok := <-s.queue:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, next)
+			default:
+				break drain
+			}
+		}
+		if err := s.send(batch); err != nil {
+			httpLog.Error(err, "Failed to send audit records, dropping them", "records", len(batch))
+			droppedRecords.WithLabelValues(dropSendFailed).Add(float64(len(batch)))
+		}
+	}
+}
+
+// send POSTs the batch, retrying with exponential backoff while the failure may be transient.
+func (s *HTTPSink) send(batch []Record) error {
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, rec := range batch {
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+	}
+
+	wait := s.backoff
+	for attempt := 1; ; attempt++ {
+		retryable, err := s.post(body.Bytes())
+		if err == nil || !retryable || attempt == httpMaxAttempts {
+			return err
+		}
+		httpLog.Info("Failed to send audit records, retrying", "records", len(batch), "attempt", attempt, "error", err.Error())
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+// post sends one request and reports whether a failure is worth retrying.
+func (s *HTTPSink) post(body []byte) (bool, error) {
+	resp, err := s.client.Post(s.endpoint, "application/x-ndjson", bytes.NewReader(body))
+	if err != nil {
+		return true, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 300 {
+		retryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return retryable, fmt.Errorf("audit endpoint returned %s", resp.Status)
+	}
+	return false, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"time"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// DefaultBurstPeriod is used when BurstSpec.Period is not set.
+const DefaultBurstPeriod = 24 * time.Hour
+
+// BurstState is the position of a budget in its burst cycle.
+type BurstState string
+
+const (
+	// BurstDisabled means the budget has no burst section
+	BurstDisabled BurstState = "Disabled"
+	// BurstAvailable means no burst has started in the current period
+	BurstAvailable BurstState = "Available"
+	// BurstActive means a burst has started and its window has not elapsed yet
+	BurstActive BurstState = "Active"
+	// BurstExpired means the burst window is used up until the period ends
+	BurstExpired BurstState = "Expired"
+)
+
+// BurstStateAt returns the burst state of the budget at the given instant.
+func BurstStateAt(b *finopsv1.ProjectBudget, now time.Time) BurstState {
+	if b.Spec.Burst == nil {
+		return BurstDisabled
+	}
+	start := b.Status.BurstStartTime
+	if start == nil || !now.Before(BurstPeriodEnd(b)) {
+		return BurstAvailable
+	}
+	if now.Before(BurstWindowEnd(b)) {
+		return BurstActive
+	}
+	return BurstExpired
+}
+
+// BurstWindowEnd is the instant the current burst stops being admitted.
+// It returns the zero time if no burst has started.
+func BurstWindowEnd(b *finopsv1.ProjectBudget) time.Time {
+	if b.Spec.Burst == nil || b.Status.BurstStartTime == nil {
+		return time.Time{}
+	}
+	return b.Status.BurstStartTime.Add(b.Spec.Burst.MaxDuration.Duration)
+}
+
+// BurstPeriodEnd is the instant the burst allowance is replenished.
+// It returns the zero time if no burst has started.
+func BurstPeriodEnd(b *finopsv1.ProjectBudget) time.Time {
+	if b.Spec.Burst == nil || b.Status.BurstStartTime == nil {
+		return time.Time{}
+	}
+	period := b.Spec.Burst.Period.Duration
+	if period <= 0 {
+		period = DefaultBurstPeriod
+	}
+	return b.Status.BurstStartTime.Add(period)
+}
+
+// BurstAllowance returns the resources admitted on top of the budget at the given instant.
+// Extra memory is only granted when the budget caps memory in the first place.
+func BurstAllowance(b *finopsv1.ProjectBudget, now time.Time) (Resources, error) {
+	switch BurstStateAt(b, now) {
+	case BurstAvailable, BurstActive:
+	default:
+		return Resources{}, nil
+	}
+
+	extra, err := parseResources(b.Spec.Burst.ExtraCpuLimit, b.Spec.Burst.ExtraMemoryLimit)
+	if err != nil {
+		return Resources{}, err
+	}
+	if b.Spec.MaxMemoryLimit == "" {
+		extra.MemoryBytes = 0
+	}
+	return extra, nil
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// ClusterLimits parses the limits of a ClusterBudget.
+func ClusterLimits(spec *finopsv1.ClusterBudgetSpec) (Resources, error) {
+	return parseResources(spec.MaxCpuLimit, spec.MaxMemoryLimit)
+}
+
+// NamespaceSelector parses the namespace selector of a ClusterBudget.
+func NamespaceSelector(spec *finopsv1.ClusterBudgetSpec) (labels.Selector, error) {
+	return metav1.LabelSelectorAsSelector(&spec.NamespaceSelector)
+}
+
+// SelectedNamespaces returns the names of the namespaces matched by selector.
+func SelectedNamespaces(selector labels.Selector, namespaces []corev1.Namespace) []string {
+	var names []string
+	for _, ns := range namespaces {
+		if selector.Matches(labels.Set(ns.Labels)) {
+			names = append(names, ns.Name)
+		}
+	}
+	return names
+}
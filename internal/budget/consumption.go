@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+const bytesPerGiB = 1 << 30
+
+// Units of the exact accumulators of a ConsumptionStatus
+const (
+	milliCoreSecondsPerCoreHour = 1000 * 3600
+	mibSecondsPerGiBHour        = 1024 * 3600
+)
+
+// Consumption is usage integrated over time: CPU core-hours and Memory GiB-hours.
+type Consumption struct {
+	CPUCoreHours   float64
+	MemoryGiBHours float64
+}
+
+// Accrue returns c plus rate held during d.
+func (c Consumption) Accrue(rate Resources, d time.Duration) Consumption {
+	if d <= 0 {
+		return c
+	}
+	hours := d.Hours()
+	return Consumption{
+		CPUCoreHours:   c.CPUCoreHours + float64(rate.CPUMilli)/1000*hours,
+		MemoryGiBHours: c.MemoryGiBHours + float64(rate.MemoryBytes)/bytesPerGiB*hours,
+	}
+}
+
+// ExhaustedBy returns the first dimension ("CPU" or "Memory") in which c has used up the
+// allowance, or "" if none. A zero allowance means "no cap" for that dimension.
+func (c Consumption) ExhaustedBy(allowance Consumption) string {
+	if allowance.CPUCoreHours > 0 && c.CPUCoreHours >= allowance.CPUCoreHours {
+		return "CPU"
+	}
+	if allowance.MemoryGiBHours > 0 && c.MemoryGiBHours >= allowance.MemoryGiBHours {
+		return "Memory"
+	}
+	return ""
+}
+
+// FormatHours renders core-hours or GiB-hours for the status.
+func FormatHours(hours float64) string {
+	return strconv.FormatFloat(hours, 'f', 3, 64)
+}
+
+// ParseConsumption reads the totals recorded in a ConsumptionStatus, from its exact accumulators
+// when set (older statuses only have the rounded hours).
+func ParseConsumption(status *finopsv1.ConsumptionStatus) (Consumption, error) {
+	if status.CpuMilliCoreSeconds != 0 || status.MemoryMiBSeconds != 0 {
+		return Consumption{
+			CPUCoreHours:   float64(status.CpuMilliCoreSeconds) / milliCoreSecondsPerCoreHour,
+			MemoryGiBHours: float64(status.MemoryMiBSeconds) / mibSecondsPerGiBHour,
+		}, nil
+	}
+	return parseHours(status.CpuCoreHours, status.MemoryGiBHours)
+}
+
+// SetConsumption records c in a ConsumptionStatus: exactly in the integer accumulators, so that
+// frequent samples of a small namespace add up instead of being rounded away, and rounded in
+// the hours shown to users.
+func SetConsumption(status *finopsv1.ConsumptionStatus, c Consumption) {
+	status.CpuMilliCoreSeconds = int64(math.Round(c.CPUCoreHours * milliCoreSecondsPerCoreHour))
+	status.MemoryMiBSeconds = int64(math.Round(c.MemoryGiBHours * mibSecondsPerGiBHour))
+	status.CpuCoreHours = FormatHours(c.CPUCoreHours)
+	status.MemoryGiBHours = FormatHours(c.MemoryGiBHours)
+}
+
+// Allowance parses the cumulative allowances of the period (zero means "no cap").
+func Allowance(spec *finopsv1.AccountingSpec) (Consumption, error) {
+	return parseHours(spec.MaxCpuCoreHours, spec.MaxMemoryGiBHours)
+}
+
+// PeriodStart returns the start of the billing period containing t (UTC boundaries).
+func PeriodStart(period finopsv1.BillingPeriod, t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if period == finopsv1.BillingPeriodWeekly {
+		// Weeks start on Monday
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// PeriodEnd returns the end of the billing period starting at start.
+func PeriodEnd(period finopsv1.BillingPeriod, start time.Time) time.Time {
+	if period == finopsv1.BillingPeriodWeekly {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 1, 0)
+}
+
+// ExhaustedAllowance reports the dimension ("CPU" or "Memory") whose allowance is used up in
+// the billing period containing now, or "" if the namespace may keep growing.
+// Consumption recorded for a previous period (not yet reset by the controller) never blocks.
+func ExhaustedAllowance(pb *finopsv1.ProjectBudget, now time.Time) (string, error) {
+	spec, status := pb.Spec.Accounting, pb.Status.Consumption
+	if spec == nil || status == nil || !status.PeriodStart.Time.Equal(PeriodStart(spec.Period, now)) {
+		return "", nil
+	}
+	allowance, err := Allowance(spec)
+	if err != nil {
+		return "", err
+	}
+	consumed, err := ParseConsumption(status)
+	if err != nil {
+		return "", err
+	}
+	return consumed.ExhaustedBy(allowance), nil
+}
+
+// parseHours converts a pair of CPU/Memory hour totals. Empty strings count as zero.
+func parseHours(cpu, memory string) (Consumption, error) {
+	var out Consumption
+	var err error
+	if cpu != "" {
+		if out.CPUCoreHours, err = strconv.ParseFloat(cpu, 64); err != nil {
+			return Consumption{}, fmt.Errorf("invalid CPU core-hours %q: %w", cpu, err)
+		}
+	}
+	if memory != "" {
+		if out.MemoryGiBHours, err = strconv.ParseFloat(memory, 64); err != nil {
+			return Consumption{}, fmt.Errorf("invalid Memory GiB-hours %q: %w", memory, err)
+		}
+	}
+	return out, nil
+}
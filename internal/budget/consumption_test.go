@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+var _ = Describe("Cumulative consumption", func() {
+	// Wednesday 4 March 2026, 10:00
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	It("should align billing periods to the month and to Mondays", func() {
+		Expect(PeriodStart(finopsv1.BillingPeriodMonthly, now)).To(Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(PeriodStart(finopsv1.BillingPeriodWeekly, now)).To(Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)))
+		Expect(PeriodEnd(finopsv1.BillingPeriodMonthly, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))).
+			To(Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should accrue core-hours and GiB-hours", func() {
+		c := Consumption{}.Accrue(Resources{CPUMilli: 1500, MemoryBytes: 2 << 30}, 2*time.Hour)
+		Expect(c.CPUCoreHours).To(BeNumerically("~", 3, 1e-9))
+		Expect(c.MemoryGiBHours).To(BeNumerically("~", 4, 1e-9))
+	})
+
+	It("should not round away the consumption of frequent samples", func() {
+		// A 100m namespace sampled every second accrues 0.0000278 core-hours each time
+		status := &finopsv1.ConsumptionStatus{}
+		for range 3600 {
+			consumed, err := ParseConsumption(status)
+			Expect(err).NotTo(HaveOccurred())
+			SetConsumption(status, consumed.Accrue(Resources{CPUMilli: 100, MemoryBytes: 1 << 30}, time.Second))
+		}
+		Expect(status.CpuCoreHours).To(Equal("0.100"))
+		Expect(status.MemoryGiBHours).To(Equal("1.000"))
+		Expect(status.CpuMilliCoreSeconds).To(Equal(int64(360000)))
+	})
+
+	It("should only report allowances exhausted in the current period", func() {
+		pb := &finopsv1.ProjectBudget{
+			Spec: finopsv1.ProjectBudgetSpec{Accounting: &finopsv1.AccountingSpec{
+				Period:          finopsv1.BillingPeriodMonthly,
+				MaxCpuCoreHours: "100",
+			}},
+			Status: finopsv1.ProjectBudgetStatus{Consumption: &finopsv1.ConsumptionStatus{
+				PeriodStart:    metav1.Time{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+				CpuCoreHours:   "100.000",
+				MemoryGiBHours: "9999.000",
+			}},
+		}
+
+		Expect(ExhaustedAllowance(pb, now)).To(Equal("CPU"))
+		Expect(ExhaustedAllowance(pb, time.Date(2026, 4, 1, 0, 5, 0, 0, time.UTC))).To(BeEmpty())
+	})
+
+	It("should estimate the consumption of a time range from the history and the samples", func() {
+		march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		status := &finopsv1.ProjectBudgetStatus{
+			ConsumptionHistory: []finopsv1.PeriodConsumption{{
+				Start:          metav1.Time{Time: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+				End:            metav1.Time{Time: march},
+				CpuCoreHours:   "672.000",
+				MemoryGiBHours: "1344.000",
+			}},
+			Consumption: &finopsv1.ConsumptionStatus{
+				PeriodStart:     metav1.Time{Time: march},
+				CpuCoreHours:    "80.000",
+				MemoryGiBHours:  "160.000",
+				LastSampleTime:  metav1.Time{Time: march.Add(80 * time.Hour)},
+				SampledCpuMilli: 2000,
+				Samples: []finopsv1.ConsumptionSample{{
+					Time:           metav1.Time{Time: march.Add(48 * time.Hour)},
+					CpuCoreHours:   "48.000",
+					MemoryGiBHours: "96.000",
+				}},
+			},
+		}
+
+		By("prorating the closed period and accruing the current one until now")
+		c, since, err := ConsumedBetween(status, time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC), now, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.CPUCoreHours).To(BeNumerically("~", 336+84, 1e-9))
+		Expect(c.MemoryGiBHours).To(BeNumerically("~", 672+160, 1e-9))
+		Expect(since).To(Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+		By("interpolating the samples within the current period")
+		c, _, err = ConsumedBetween(status, march.Add(24*time.Hour), march.Add(60*time.Hour), now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.CPUCoreHours).To(BeNumerically("~", 36, 1e-9))
+		Expect(c.MemoryGiBHours).To(BeNumerically("~", 72, 1e-9))
+
+		By("reporting nothing before the recorded history")
+		c, _, err = ConsumedBetween(status, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).To(Equal(Consumption{}))
+	})
+})
@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// defaultedResources are the resources a budget counts, in the order they are defaulted.
+var defaultedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// ContainerDefaultValues resolves the limits and requests to inject into containers: the values of
+// the budget first, then the Container defaults of the LimitRanges (the first LimitRange wins).
+func ContainerDefaultValues(defaults *finopsv1.ContainerDefaults, limitRanges []corev1.LimitRange) (corev1.ResourceList, corev1.ResourceList, error) {
+	limits, requests := corev1.ResourceList{}, corev1.ResourceList{}
+	if defaults != nil {
+		for _, v := range []struct {
+			list  corev1.ResourceList
+			name  corev1.ResourceName
+			value string
+		}{
+			{limits, corev1.ResourceCPU, defaults.CpuLimit},
+			{limits, corev1.ResourceMemory, defaults.MemoryLimit},
+			{requests, corev1.ResourceCPU, defaults.CpuRequest},
+			{requests, corev1.ResourceMemory, defaults.MemoryRequest},
+		} {
+			if v.value == "" {
+				continue
+			}
+			q, err := resource.ParseQuantity(v.value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid default for %s: %w", v.name, err)
+			}
+			v.list[v.name] = q
+		}
+	}
+
+	for _, lr := range limitRanges {
+		for _, item := range lr.Spec.Limits {
+			if item.Type != corev1.LimitTypeContainer {
+				continue
+			}
+			for _, name := range defaultedResources {
+				if q, ok := item.Default[name]; ok {
+					if _, set := limits[name]; !set {
+						limits[name] = q
+					}
+				}
+				if q, ok := item.DefaultRequest[name]; ok {
+					if _, set := requests[name]; !set {
+						requests[name] = q
+					}
+				}
+			}
+		}
+	}
+	return limits, requests, nil
+}
+
+// ApplyContainerDefaults sets the missing CPU/Memory limits and requests of the pod containers.
+// An injected limit is raised to the request already set, and an injected request is capped to
+// the limit, so the pod stays valid. It returns what was injected as "container/limits.cpu=500m".
+func ApplyContainerDefaults(pod *corev1.Pod, limits, requests corev1.ResourceList) []string {
+	var injected []string
+	for i := range pod.Spec.Containers {
+		c := &pod.Spec.Containers[i]
+		for _, name := range defaultedResources {
+			if q, ok := limits[name]; ok {
+				if _, set := c.Resources.Limits[name]; !set {
+					if request, ok := c.Resources.Requests[name]; ok && request.Cmp(q) > 0 {
+						q = request
+					}
+					if c.Resources.Limits == nil {
+						c.Resources.Limits = corev1.ResourceList{}
+					}
+					c.Resources.Limits[name] = q
+					injected = append(injected, fmt.Sprintf("%s/limits.%s=%s", c.Name, name, q.String()))
+				}
+			}
+			if q, ok := requests[name]; ok {
+				if _, set := c.Resources.Requests[name]; !set {
+					if limit, ok := c.Resources.Limits[name]; ok && q.Cmp(limit) > 0 {
+						q = limit
+					}
+					if c.Resources.Requests == nil {
+						c.Resources.Requests = corev1.ResourceList{}
+					}
+					c.Resources.Requests[name] = q
+					injected = append(injected, fmt.Sprintf("%s/requests.%s=%s", c.Name, name, q.String()))
+				}
+			}
+		}
+	}
+	return injected
+}
+
+// MissingLimit is a container limit a budget counts but the pod doesn't set.
+type MissingLimit struct {
+	// Index is the position of the container in the pod spec
+	Index     int
+	Container string
+	Resource  corev1.ResourceName
+}
+
+// String formats the missing limit as "container (cpu)".
+func (m MissingLimit) String() string {
+	return fmt.Sprintf("%s (%s)", m.Container, m.Resource)
+}
+
+// Field is the path of the missing limit in the pod (e.g., "spec.containers[0].resources.limits.cpu").
+func (m MissingLimit) Field() string {
+	return fmt.Sprintf("spec.containers[%d].resources.limits.%s", m.Index, m.Resource)
+}
+
+// MissingLimits lists the containers without a CPU limit, or without a Memory limit when
+// memory is true.
+func MissingLimits(pod *corev1.Pod, memory bool) []MissingLimit {
+	var missing []MissingLimit
+	for i, c := range pod.Spec.Containers {
+		if _, ok := c.Resources.Limits[corev1.ResourceCPU]; !ok {
+			missing = append(missing, MissingLimit{Index: i, Container: c.Name, Resource: corev1.ResourceCPU})
+		}
+		if _, ok := c.Resources.Limits[corev1.ResourceMemory]; memory && !ok {
+			missing = append(missing, MissingLimit{Index: i, Container: c.Name, Resource: corev1.ResourceMemory})
+		}
+	}
+	return missing
+}
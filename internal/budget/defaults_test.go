@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+var _ = Describe("Container defaults", func() {
+	limitRange := corev1.LimitRange{Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+		Type: corev1.LimitTypeContainer,
+		Default: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("1Gi"),
+		},
+		DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+	}}}}
+
+	It("should prefer the budget values and fall back to the LimitRange", func() {
+		limits, requests, err := ContainerDefaultValues(&finopsv1.ContainerDefaults{CpuLimit: "500m"},
+			[]corev1.LimitRange{limitRange})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(limits.Cpu().String()).To(Equal("500m"))
+		Expect(limits.Memory().String()).To(Equal("1Gi"))
+		Expect(requests.Cpu().String()).To(Equal("100m"))
+		Expect(requests).NotTo(HaveKey(corev1.ResourceMemory))
+	})
+
+	It("should only fill what the containers omit and keep them valid", func() {
+		pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "app", Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			}},
+			{Name: "sidecar", Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+			}},
+		}}}
+		limits, requests, err := ContainerDefaultValues(nil, []corev1.LimitRange{limitRange})
+		Expect(err).NotTo(HaveOccurred())
+
+		injected := ApplyContainerDefaults(pod, limits, requests)
+		Expect(injected).To(Equal([]string{
+			"app/limits.cpu=2",
+			"app/limits.memory=1Gi",
+			"sidecar/requests.cpu=50m",
+			"sidecar/limits.memory=1Gi",
+		}))
+		Expect(MissingLimits(pod, true)).To(BeEmpty())
+	})
+
+	It("should list the containers without limits", func() {
+		pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name: "app",
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+		}}}}
+
+		Expect(MissingLimits(pod, false)).To(BeEmpty())
+		missing := MissingLimits(pod, true)
+		Expect(missing).To(HaveLen(1))
+		Expect(missing[0].String()).To(Equal("app (memory)"))
+		Expect(missing[0].Field()).To(Equal("spec.containers[0].resources.limits.memory"))
+	})
+})
@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"math"
+	"time"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// ewmaAlpha is the weight of the most recent rate in the EWMA forecast.
+const ewmaAlpha = 0.3
+
+// Sample is the consumption of the period at a point in time.
+type Sample struct {
+	Time time.Time
+	Consumption
+}
+
+// ParseSamples reads the samples recorded in a ConsumptionStatus.
+func ParseSamples(status *finopsv1.ConsumptionStatus) ([]Sample, error) {
+	samples := make([]Sample, 0, len(status.Samples))
+	for _, s := range status.Samples {
+		c, err := parseHours(s.CpuCoreHours, s.MemoryGiBHours)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, Sample{Time: s.Time.Time, Consumption: c})
+	}
+	return samples, nil
+}
+
+// ConsumptionRate estimates how much is consumed per hour from samples ordered by time.
+// It returns false when the samples don't span any time yet.
+func ConsumptionRate(model finopsv1.ForecastModel, samples []Sample) (Consumption, bool) {
+	if len(samples) < 2 || !samples[len(samples)-1].Time.After(samples[0].Time) {
+		return Consumption{}, false
+	}
+	if model == finopsv1.ForecastEWMA {
+		return ewmaRate(samples), true
+	}
+	return linearRate(samples), true
+}
+
+// Project extrapolates consumed at rate (per hour) until the given time.
+func Project(consumed, rate Consumption, now, until time.Time) Consumption {
+	hours := until.Sub(now).Hours()
+	if hours < 0 {
+		hours = 0
+	}
+	return Consumption{
+		CPUCoreHours:   consumed.CPUCoreHours + rate.CPUCoreHours*hours,
+		MemoryGiBHours: consumed.MemoryGiBHours + rate.MemoryGiBHours*hours,
+	}
+}
+
+// ExhaustionTime returns when consumed, growing at rate, reaches the allowance of dimension
+// ("CPU" or "Memory"). It returns false if that never happens.
+func ExhaustionTime(dimension string, consumed, rate, allowance Consumption, now time.Time) (time.Time, bool) {
+	left, perHour := allowance.CPUCoreHours-consumed.CPUCoreHours, rate.CPUCoreHours
+	if dimension == "Memory" {
+		left, perHour = allowance.MemoryGiBHours-consumed.MemoryGiBHours, rate.MemoryGiBHours
+	}
+	if left <= 0 {
+		return now, true
+	}
+	if perHour <= 0 {
+		return time.Time{}, false
+	}
+	return now.Add(time.Duration(left / perHour * float64(time.Hour))), true
+}
+
+// linearRate is the least squares slope of the consumption over time.
+func linearRate(samples []Sample) Consumption {
+	origin := samples[0].Time
+	var sumX, sumCPU, sumMem float64
+	for _, s := range samples {
+		sumX += s.Time.Sub(origin).Hours()
+		sumCPU += s.CPUCoreHours
+		sumMem += s.MemoryGiBHours
+	}
+	n := float64(len(samples))
+	meanX, meanCPU, meanMem := sumX/n, sumCPU/n, sumMem/n
+
+	var varX, covCPU, covMem float64
+	for _, s := range samples {
+		dx := s.Time.Sub(origin).Hours() - meanX
+		varX += dx * dx
+		covCPU += dx * (s.CPUCoreHours - meanCPU)
+		covMem += dx * (s.MemoryGiBHours - meanMem)
+	}
+	return Consumption{CPUCoreHours: math.Max(covCPU/varX, 0), MemoryGiBHours: math.Max(covMem/varX, 0)}
+}
+
+// ewmaRate smooths the rates between consecutive samples, the most recent weighing the most.
+func ewmaRate(samples []Sample) Consumption {
+	var rate Consumption
+	first := true
+	for i := 1; i < len(samples); i++ {
+		hours := samples[i].Time.Sub(samples[i-1].Time).Hours()
+		if hours <= 0 {
+			continue
+		}
+		step := Consumption{
+			CPUCoreHours:   (samples[i].CPUCoreHours - samples[i-1].CPUCoreHours) / hours,
+			MemoryGiBHours: (samples[i].MemoryGiBHours - samples[i-1].MemoryGiBHours) / hours,
+		}
+		if first {
+			rate, first = step, false
+			continue
+		}
+		rate.CPUCoreHours = ewmaAlpha*step.CPUCoreHours + (1-ewmaAlpha)*rate.CPUCoreHours
+		rate.MemoryGiBHours = ewmaAlpha*step.MemoryGiBHours + (1-ewmaAlpha)*rate.MemoryGiBHours
+	}
+	return rate
+}
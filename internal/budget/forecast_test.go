@@ -0,0 +1,68 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+var _ = Describe("Consumption forecast", func() {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	sample := func(hours, cpu float64) Sample {
+		return Sample{Time: start.Add(time.Duration(hours * float64(time.Hour))), Consumption: Consumption{CPUCoreHours: cpu}}
+	}
+
+	It("should not forecast from a single sample", func() {
+		_, ok := ConsumptionRate(finopsv1.ForecastLinear, []Sample{sample(0, 0)})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should fit a straight line through the samples", func() {
+		rate, ok := ConsumptionRate(finopsv1.ForecastLinear, []Sample{sample(0, 0), sample(1, 4), sample(2, 8), sample(3, 12)})
+		Expect(ok).To(BeTrue())
+		Expect(rate.CPUCoreHours).To(BeNumerically("~", 4, 1e-9))
+	})
+
+	It("should weigh recent rates more with EWMA", func() {
+		// 2 cores for two hours, then 10 cores
+		samples := []Sample{sample(0, 0), sample(1, 2), sample(2, 4), sample(3, 14)}
+
+		linear, _ := ConsumptionRate(finopsv1.ForecastLinear, samples)
+		ewma, ok := ConsumptionRate(finopsv1.ForecastEWMA, samples)
+		Expect(ok).To(BeTrue())
+		Expect(ewma.CPUCoreHours).To(BeNumerically("~", 0.3*10+0.7*2, 1e-9))
+		Expect(ewma.CPUCoreHours).To(BeNumerically(">", linear.CPUCoreHours-1))
+	})
+
+	It("should project to the end of the period and find when the allowance runs out", func() {
+		now := start.Add(24 * time.Hour)
+		consumed := Consumption{CPUCoreHours: 96}
+		rate := Consumption{CPUCoreHours: 4}
+
+		projected := Project(consumed, rate, now, PeriodEnd(finopsv1.BillingPeriodMonthly, start))
+		Expect(projected.CPUCoreHours).To(BeNumerically("~", 96+4*30*24, 1e-9))
+
+		at, ok := ExhaustionTime("CPU", consumed, rate, Consumption{CPUCoreHours: 1000}, now)
+		Expect(ok).To(BeTrue())
+		Expect(at).To(Equal(now.Add(226 * time.Hour)))
+	})
+})
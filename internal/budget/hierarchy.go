@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// Key returns the namespaced name of a budget.
+func Key(pb *finopsv1.ProjectBudget) types.NamespacedName {
+	return types.NamespacedName{Name: pb.Name, Namespace: pb.Namespace}
+}
+
+// ParentKey returns the namespaced name of the parent of pb, if it has one.
+func ParentKey(pb *finopsv1.ProjectBudget) (types.NamespacedName, bool) {
+	ref := pb.Spec.ParentRef
+	if ref == nil {
+		return types.NamespacedName{}, false
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = pb.Namespace
+	}
+	return types.NamespacedName{Name: ref.Name, Namespace: namespace}, true
+}
+
+// Ancestors returns the parents of pb found in budgets, closest first. It fails when a
+// parent is missing or the chain loops back.
+func Ancestors(pb *finopsv1.ProjectBudget, budgets []finopsv1.ProjectBudget) ([]*finopsv1.ProjectBudget, error) {
+	byKey := make(map[types.NamespacedName]*finopsv1.ProjectBudget, len(budgets))
+	for i := range budgets {
+		byKey[Key(&budgets[i])] = &budgets[i]
+	}
+
+	var ancestors []*finopsv1.ProjectBudget
+	seen := map[types.NamespacedName]bool{Key(pb): true}
+	current := pb
+	for {
+		parentKey, ok := ParentKey(current)
+		if !ok {
+			return ancestors, nil
+		}
+		if seen[parentKey] {
+			return nil, fmt.Errorf("budget hierarchy loops back to %s", parentKey)
+		}
+		parent, found := byKey[parentKey]
+		if !found {
+			return nil, fmt.Errorf("parent budget %s not found", parentKey)
+		}
+		seen[parentKey] = true
+		ancestors = append(ancestors, parent)
+		current = parent
+	}
+}
+
+// Children returns the budgets whose parent is pb.
+func Children(pb *finopsv1.ProjectBudget, budgets []finopsv1.ProjectBudget) []*finopsv1.ProjectBudget {
+	key := Key(pb)
+	var children []*finopsv1.ProjectBudget
+	for i := range budgets {
+		if parentKey, ok := ParentKey(&budgets[i]); ok && parentKey == key && Key(&budgets[i]) != key {
+			children = append(children, &budgets[i])
+		}
+	}
+	return children
+}
+
+// SubtreeNamespaces returns the namespaces governed by pb and all its descendants.
+func SubtreeNamespaces(pb *finopsv1.ProjectBudget, budgets []finopsv1.ProjectBudget) []string {
+	var namespaces []string
+	seenNamespace := map[string]bool{}
+	visited := map[types.NamespacedName]bool{}
+
+	queue := []*finopsv1.ProjectBudget{pb}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if visited[Key(current)] {
+			continue
+		}
+		visited[Key(current)] = true
+		if !seenNamespace[current.Spec.TeamName] {
+			seenNamespace[current.Spec.TeamName] = true
+			namespaces = append(namespaces, current.Spec.TeamName)
+		}
+		queue = append(queue, Children(current, budgets)...)
+	}
+	return namespaces
+}
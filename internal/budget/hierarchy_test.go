@@ -0,0 +1,71 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// newNode returns a budget for namespace ns whose parent (in the same namespace) is parent.
+func newNode(name, ns, parent string) finopsv1.ProjectBudget {
+	pb := finopsv1.ProjectBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "finops"},
+		Spec:       finopsv1.ProjectBudgetSpec{TeamName: ns},
+	}
+	if parent != "" {
+		pb.Spec.ParentRef = &finopsv1.BudgetReference{Name: parent}
+	}
+	return pb
+}
+
+var _ = Describe("Budget hierarchy", func() {
+	budgets := []finopsv1.ProjectBudget{
+		newNode("org", "org-shared", ""),
+		newNode("data", "data-shared", "org"),
+		newNode("team-a", "team-a", "data"),
+		newNode("team-b", "team-b", "data"),
+	}
+
+	It("should walk the ancestors closest first", func() {
+		ancestors, err := Ancestors(&budgets[2], budgets)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ancestors).To(HaveLen(2))
+		Expect(ancestors[0].Name).To(Equal("data"))
+		Expect(ancestors[1].Name).To(Equal("org"))
+	})
+
+	It("should collect the namespaces of a whole subtree", func() {
+		Expect(SubtreeNamespaces(&budgets[0], budgets)).
+			To(ConsistOf("org-shared", "data-shared", "team-a", "team-b"))
+		Expect(Children(&budgets[1], budgets)).To(HaveLen(2))
+	})
+
+	It("should reject missing parents and loops", func() {
+		orphan := newNode("orphan", "team-c", "missing")
+		_, err := Ancestors(&orphan, budgets)
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+
+		looping := []finopsv1.ProjectBudget{newNode("a", "ns-a", "b"), newNode("b", "ns-b", "a")}
+		_, err = Ancestors(&looping[0], looping)
+		Expect(err).To(MatchError(ContainSubstring("loops back")))
+	})
+})
@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"time"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// ConsumedBetween estimates the consumption recorded in the status of a budget between start
+// and end. Closed periods only keep their totals and are prorated by their overlap with the
+// range; within the current period the hourly samples are interpolated, and the usage held
+// since the last sample is accrued until now. It also returns when the recorded history
+// begins (zero if nothing was recorded), so callers can tell a range it doesn't fully cover.
+func ConsumedBetween(status *finopsv1.ProjectBudgetStatus, start, end, now time.Time) (Consumption, time.Time, error) {
+	var total Consumption
+	var since time.Time
+
+	for _, p := range status.ConsumptionHistory {
+		c, err := parseHours(p.CpuCoreHours, p.MemoryGiBHours)
+		if err != nil {
+			return Consumption{}, time.Time{}, err
+		}
+		if length := p.End.Sub(p.Start.Time); length > 0 {
+			total = total.add(c.scale(float64(overlap(start, end, p.Start.Time, p.End.Time)) / float64(length)))
+		}
+		since = earliest(since, p.Start.Time)
+	}
+
+	if current := status.Consumption; current != nil {
+		points, err := cumulativePoints(current, now)
+		if err != nil {
+			return Consumption{}, time.Time{}, err
+		}
+		from, to := start, end
+		if from.Before(current.PeriodStart.Time) {
+			from = current.PeriodStart.Time
+		}
+		if to.After(from) {
+			total = total.add(consumedAt(points, to).sub(consumedAt(points, from)))
+		}
+		since = earliest(since, current.PeriodStart.Time)
+	}
+	return total, since, nil
+}
+
+// cumulativePoints lists the known totals of the current period in time order: zero at its
+// start, the hourly samples, the last sample and the accrual until now.
+func cumulativePoints(status *finopsv1.ConsumptionStatus, now time.Time) ([]Sample, error) {
+	samples, err := ParseSamples(status)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := ParseConsumption(status)
+	if err != nil {
+		return nil, err
+	}
+
+	last := status.LastSampleTime.Time
+	points := []Sample{{Time: status.PeriodStart.Time}}
+	for _, s := range samples {
+		if s.Time.After(points[len(points)-1].Time) && s.Time.Before(last) {
+			points = append(points, s)
+		}
+	}
+	if last.After(points[len(points)-1].Time) {
+		points = append(points, Sample{Time: last, Consumption: totals})
+	}
+	if now.After(last) {
+		rate := Resources{CPUMilli: status.SampledCpuMilli, MemoryBytes: status.SampledMemoryBytes}
+		points = append(points, Sample{Time: now, Consumption: totals.Accrue(rate, now.Sub(last))})
+	}
+	return points, nil
+}
+
+// consumedAt interpolates the cumulative consumption at t between the points around it.
+func consumedAt(points []Sample, t time.Time) Consumption {
+	if !t.After(points[0].Time) {
+		return points[0].Consumption
+	}
+	for i := 1; i < len(points); i++ {
+		if t.After(points[i].Time) {
+			continue
+		}
+		prev, next := points[i-1], points[i]
+		fraction := float64(t.Sub(prev.Time)) / float64(next.Time.Sub(prev.Time))
+		return prev.Consumption.add(next.Consumption.sub(prev.Consumption).scale(fraction))
+	}
+	return points[len(points)-1].Consumption
+}
+
+// overlap returns how long [start, end) and [from, to) have in common.
+func overlap(start, end, from, to time.Time) time.Duration {
+	if from.Before(start) {
+		from = start
+	}
+	if to.After(end) {
+		to = end
+	}
+	if !to.After(from) {
+		return 0
+	}
+	return to.Sub(from)
+}
+
+// earliest returns the earlier of a (zero meaning unset) and b.
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func (c Consumption) add(o Consumption) Consumption {
+	return Consumption{CPUCoreHours: c.CPUCoreHours + o.CPUCoreHours, MemoryGiBHours: c.MemoryGiBHours + o.MemoryGiBHours}
+}
+
+func (c Consumption) sub(o Consumption) Consumption {
+	return Consumption{CPUCoreHours: c.CPUCoreHours - o.CPUCoreHours, MemoryGiBHours: c.MemoryGiBHours - o.MemoryGiBHours}
+}
+
+func (c Consumption) scale(f float64) Consumption {
+	return Consumption{CPUCoreHours: c.CPUCoreHours * f, MemoryGiBHours: c.MemoryGiBHours * f}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// Loan is headroom of a lender budget used by a borrower budget.
+type Loan struct {
+	Lender   types.NamespacedName
+	Borrower types.NamespacedName
+	Resources
+}
+
+// LendsTo reports whether the lending policy of lender lists borrower.
+func LendsTo(lender, borrower *finopsv1.ProjectBudget) bool {
+	if lender.Spec.Lending == nil || Key(lender) == Key(borrower) {
+		return false
+	}
+	for _, ref := range lender.Spec.Lending.Borrowers {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = lender.Namespace
+		}
+		if (types.NamespacedName{Name: ref.Name, Namespace: namespace}) == Key(borrower) {
+			return true
+		}
+	}
+	return false
+}
+
+// Lenders returns the budgets lending to pb, in order of namespaced name.
+func Lenders(pb *finopsv1.ProjectBudget, budgets []finopsv1.ProjectBudget) []*finopsv1.ProjectBudget {
+	var lenders []*finopsv1.ProjectBudget
+	for i := range budgets {
+		if LendsTo(&budgets[i], pb) {
+			lenders = append(lenders, &budgets[i])
+		}
+	}
+	sortByKey(lenders)
+	return lenders
+}
+
+// Borrowers returns the budgets pb lends to, in order of namespaced name.
+func Borrowers(pb *finopsv1.ProjectBudget, budgets []finopsv1.ProjectBudget) []*finopsv1.ProjectBudget {
+	var borrowers []*finopsv1.ProjectBudget
+	for i := range budgets {
+		if LendsTo(pb, &budgets[i]) {
+			borrowers = append(borrowers, &budgets[i])
+		}
+	}
+	sortByKey(borrowers)
+	return borrowers
+}
+
+// LendingCap returns the most lender may lend at once (zero without a lending policy).
+func LendingCap(lender *finopsv1.ProjectBudget) (Resources, error) {
+	if lender.Spec.Lending == nil {
+		return Resources{}, nil
+	}
+	return parseResources(lender.Spec.Lending.MaxLentCpu, lender.Spec.Lending.MaxLentMemory)
+}
+
+// Lendable returns what lender can lend while it uses used: its lending cap, limited by the
+// headroom it leaves free under the limits in force at now (all of the cap without a Memory limit).
+func Lendable(lender *finopsv1.ProjectBudget, used Resources, now time.Time) (Resources, error) {
+	limits, _, err := EffectiveLimits(&lender.Spec, now)
+	if err != nil {
+		return Resources{}, err
+	}
+	free, err := LendingCap(lender)
+	if err != nil {
+		return Resources{}, err
+	}
+	free.CPUMilli = min(free.CPUMilli, max(limits.CPUMilli-used.CPUMilli, 0))
+	if limits.MemoryBytes > 0 {
+		free.MemoryBytes = min(free.MemoryBytes, max(limits.MemoryBytes-used.MemoryBytes, 0))
+	}
+	return free, nil
+}
+
+// AllocateLoans covers the usage of each borrower above its own limits with the headroom its
+// lenders don't use, up to the cap of their lending policy. Borrowers and lenders are served
+// in order of namespaced name so every caller gets the same loans. Limits are those in force at
+// now (see EffectiveLimits). usage maps namespaces to their usage; budgets whose namespace is
+// missing are left out.
+//
+// A lender taking its capacity back simply shrinks its loans: whatever a borrower uses beyond
+// its limits and its loans is over budget again.
+func AllocateLoans(budgets []finopsv1.ProjectBudget, usage map[string]Resources, now time.Time) ([]Loan, error) {
+	lendable := map[types.NamespacedName]Resources{}
+	for i := range budgets {
+		lender := &budgets[i]
+		used, ok := usage[lender.Spec.TeamName]
+		if lender.Spec.Lending == nil || !ok {
+			continue
+		}
+		free, err := Lendable(lender, used, now)
+		if err != nil {
+			return nil, err
+		}
+		lendable[Key(lender)] = free
+	}
+
+	borrowers := make([]*finopsv1.ProjectBudget, 0, len(budgets))
+	for i := range budgets {
+		borrowers = append(borrowers, &budgets[i])
+	}
+	sortByKey(borrowers)
+
+	var loans []Loan
+	for _, borrower := range borrowers {
+		used, ok := usage[borrower.Spec.TeamName]
+		if !ok {
+			continue
+		}
+		limits, _, err := EffectiveLimits(&borrower.Spec, now)
+		if err != nil {
+			return nil, err
+		}
+		needed := used.Excess(limits)
+		for _, lender := range Lenders(borrower, budgets) {
+			if needed == (Resources{}) {
+				break
+			}
+			free := lendable[Key(lender)]
+			lent := Resources{
+				CPUMilli:    min(needed.CPUMilli, free.CPUMilli),
+				MemoryBytes: min(needed.MemoryBytes, free.MemoryBytes),
+			}
+			if lent == (Resources{}) {
+				continue
+			}
+			needed = Resources{CPUMilli: needed.CPUMilli - lent.CPUMilli, MemoryBytes: needed.MemoryBytes - lent.MemoryBytes}
+			lendable[Key(lender)] = Resources{CPUMilli: free.CPUMilli - lent.CPUMilli, MemoryBytes: free.MemoryBytes - lent.MemoryBytes}
+			loans = append(loans, Loan{Lender: Key(lender), Borrower: Key(borrower), Resources: lent})
+		}
+	}
+	return loans, nil
+}
+
+// Borrowed sums the loans made to borrower.
+func Borrowed(loans []Loan, borrower types.NamespacedName) Resources {
+	var total Resources
+	for _, loan := range loans {
+		if loan.Borrower == borrower {
+			total = total.Add(loan.Resources)
+		}
+	}
+	return total
+}
+
+// LendingNamespaces returns the namespaces whose usage AllocateLoans needs to settle the loans
+// of pb: those of every budget connected to it by lending policies, directly or not.
+func LendingNamespaces(pb *finopsv1.ProjectBudget, budgets []finopsv1.ProjectBudget) []string {
+	var namespaces []string
+	seenNamespace := map[string]bool{}
+	visited := map[types.NamespacedName]bool{}
+
+	queue := []*finopsv1.ProjectBudget{pb}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if visited[Key(current)] {
+			continue
+		}
+		visited[Key(current)] = true
+		if !seenNamespace[current.Spec.TeamName] {
+			seenNamespace[current.Spec.TeamName] = true
+			namespaces = append(namespaces, current.Spec.TeamName)
+		}
+		queue = append(queue, Lenders(current, budgets)...)
+		queue = append(queue, Borrowers(current, budgets)...)
+	}
+	return namespaces
+}
+
+// sortByKey orders budgets by namespace and name.
+func sortByKey(budgets []*finopsv1.ProjectBudget) {
+	sort.Slice(budgets, func(i, j int) bool {
+		return Key(budgets[i]).String() < Key(budgets[j]).String()
+	})
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// newLender returns a budget of cpu for namespace ns lending up to maxLent to borrowers.
+func newLender(name, cpu, maxLent string, borrowers ...string) finopsv1.ProjectBudget {
+	pb := finopsv1.ProjectBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "finops"},
+		Spec:       finopsv1.ProjectBudgetSpec{TeamName: name, MaxCpuLimit: cpu},
+	}
+	if len(borrowers) > 0 {
+		pb.Spec.Lending = &finopsv1.LendingSpec{MaxLentCpu: maxLent}
+		for _, b := range borrowers {
+			pb.Spec.Lending.Borrowers = append(pb.Spec.Lending.Borrowers, finopsv1.BudgetReference{Name: b})
+		}
+	}
+	return pb
+}
+
+var _ = Describe("Budget lending", func() {
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	borrower := types.NamespacedName{Name: "team-b", Namespace: "finops"}
+
+	It("should cover the excess of a borrower with the unused headroom of its lenders", func() {
+		budgets := []finopsv1.ProjectBudget{
+			newLender("team-a", "2000m", "1000m", "team-b"),
+			newLender("team-b", "1000m", ""),
+		}
+		usage := map[string]Resources{"team-a": {CPUMilli: 500}, "team-b": {CPUMilli: 1600}}
+
+		loans, err := AllocateLoans(budgets, usage, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loans).To(HaveLen(1))
+		Expect(loans[0].Lender.Name).To(Equal("team-a"))
+		Expect(Borrowed(loans, borrower)).To(Equal(Resources{CPUMilli: 600}))
+	})
+
+	It("should never lend more than the cap nor what the lender uses", func() {
+		budgets := []finopsv1.ProjectBudget{
+			newLender("team-a", "2000m", "1000m", "team-b"),
+			newLender("team-b", "1000m", ""),
+		}
+
+		loans, err := AllocateLoans(budgets, map[string]Resources{"team-a": {}, "team-b": {CPUMilli: 3000}}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(Borrowed(loans, borrower).CPUMilli).To(Equal(int64(1000)))
+
+		// The lender takes its capacity back
+		loans, err = AllocateLoans(budgets, map[string]Resources{"team-a": {CPUMilli: 1800}, "team-b": {CPUMilli: 3000}}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(Borrowed(loans, borrower).CPUMilli).To(Equal(int64(200)))
+	})
+
+	It("should only lend to the listed borrowers", func() {
+		budgets := []finopsv1.ProjectBudget{
+			newLender("team-a", "2000m", "1000m", "team-b"),
+			newLender("team-b", "1000m", ""),
+			newLender("team-c", "1000m", ""),
+		}
+		Expect(Lenders(&budgets[2], budgets)).To(BeEmpty())
+		Expect(LendingNamespaces(&budgets[1], budgets)).To(ConsistOf("team-a", "team-b"))
+	})
+})
@@ -0,0 +1,111 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// cronParser accepts the classic 5-field cron syntax (minute hour dom month dow).
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// parseSchedule parses a window schedule, evaluating it in UTC unless CRON_TZ is given.
+func parseSchedule(w *finopsv1.BudgetWindow) (cron.Schedule, error) {
+	sched, err := cronParser.Parse(w.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q for window %q: %w", w.Schedule, w.Name, err)
+	}
+	if spec, ok := sched.(*cron.SpecSchedule); ok && spec.Location == time.Local {
+		spec.Location = time.UTC
+	}
+	return sched, nil
+}
+
+// windowOpenedAt returns the activation that keeps the window open at now, if any.
+func windowOpenedAt(w *finopsv1.BudgetWindow, now time.Time) (time.Time, bool, error) {
+	sched, err := parseSchedule(w)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	// The first activation after (now - duration) is the only one that can still be open
+	opened := sched.Next(now.Add(-w.Duration.Duration))
+	return opened, !opened.After(now), nil
+}
+
+// ActiveWindow returns the first window open at now, or nil when the default limits apply.
+func ActiveWindow(spec *finopsv1.ProjectBudgetSpec, now time.Time) (*finopsv1.BudgetWindow, error) {
+	for i := range spec.Schedules {
+		_, open, err := windowOpenedAt(&spec.Schedules[i], now)
+		if err != nil {
+			return nil, err
+		}
+		if open {
+			return &spec.Schedules[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// NextWindowBoundary returns the next instant a window opens or closes.
+// It returns the zero time when the budget has no schedules.
+func NextWindowBoundary(spec *finopsv1.ProjectBudgetSpec, now time.Time) (time.Time, error) {
+	var next time.Time
+	earliest := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	for i := range spec.Schedules {
+		w := &spec.Schedules[i]
+		opened, open, err := windowOpenedAt(w, now)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if open {
+			earliest(opened.Add(w.Duration.Duration))
+		}
+		sched, _ := parseSchedule(w)
+		earliest(sched.Next(now))
+	}
+	return next, nil
+}
+
+// EffectiveLimits returns the limits in force at now and the name of the window providing
+// them (empty when the default MaxCpuLimit/MaxMemoryLimit apply).
+func EffectiveLimits(spec *finopsv1.ProjectBudgetSpec, now time.Time) (Resources, string, error) {
+	window, err := ActiveWindow(spec, now)
+	if err != nil {
+		return Resources{}, "", err
+	}
+	if window == nil {
+		limits, err := Limits(spec)
+		return limits, "", err
+	}
+
+	memory := window.MaxMemoryLimit
+	if memory == "" {
+		memory = spec.MaxMemoryLimit
+	}
+	limits, err := parseResources(window.MaxCpuLimit, memory)
+	return limits, window.Name, err
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+var _ = Describe("Budget windows", func() {
+	var spec *finopsv1.ProjectBudgetSpec
+
+	BeforeEach(func() {
+		spec = &finopsv1.ProjectBudgetSpec{
+			TeamName:       "team-dev",
+			MaxCpuLimit:    "8000m",
+			MaxMemoryLimit: "16Gi",
+			Schedules: []finopsv1.BudgetWindow{
+				{
+					Name:        "nights",
+					Schedule:    "0 20 * * *",
+					Duration:    metav1.Duration{Duration: 12 * time.Hour},
+					MaxCpuLimit: "500m",
+				},
+				{
+					Name:           "weekends",
+					Schedule:       "0 0 * * 6",
+					Duration:       metav1.Duration{Duration: 48 * time.Hour},
+					MaxCpuLimit:    "250m",
+					MaxMemoryLimit: "1Gi",
+				},
+			},
+		}
+	})
+
+	It("should use the default limits during working hours", func() {
+		// Wednesday 10:00
+		limits, window, err := EffectiveLimits(spec, time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(window).To(BeEmpty())
+		Expect(limits).To(Equal(Resources{CPUMilli: 8000, MemoryBytes: 16 << 30}))
+	})
+
+	It("should use the first open window, inheriting the default memory limit", func() {
+		// Wednesday 23:00
+		limits, window, err := EffectiveLimits(spec, time.Date(2026, 3, 4, 23, 0, 0, 0, time.UTC))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(window).To(Equal("nights"))
+		Expect(limits).To(Equal(Resources{CPUMilli: 500, MemoryBytes: 16 << 30}))
+	})
+
+	It("should use a later window when earlier ones are closed", func() {
+		// Saturday 12:00
+		limits, window, err := EffectiveLimits(spec, time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(window).To(Equal("weekends"))
+		Expect(limits).To(Equal(Resources{CPUMilli: 250, MemoryBytes: 1 << 30}))
+	})
+
+	It("should report the next window boundary", func() {
+		// Wednesday 23:00: "nights" closes on Thursday at 08:00
+		now := time.Date(2026, 3, 4, 23, 0, 0, 0, time.UTC)
+		Expect(NextWindowBoundary(spec, now)).To(Equal(time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC)))
+
+		// Wednesday 10:00: "nights" opens at 20:00
+		now = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+		Expect(NextWindowBoundary(spec, now)).To(Equal(time.Date(2026, 3, 4, 20, 0, 0, 0, time.UTC)))
+	})
+
+	It("should reject invalid cron expressions", func() {
+		spec.Schedules[0].Schedule = "every night"
+		_, _, err := EffectiveLimits(spec, time.Now())
+		Expect(err).To(MatchError(ContainSubstring("invalid schedule")))
+	})
+})
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package budget holds the accounting rules shared by the ProjectBudget
+// controller and the Pod admission webhooks.
+package budget
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// Resources is an amount of CPU (millicores) and Memory (bytes).
+type Resources struct {
+	CPUMilli    int64
+	MemoryBytes int64
+}
+
+// Add returns the sum of r and o.
+func (r Resources) Add(o Resources) Resources {
+	return Resources{CPUMilli: r.CPUMilli + o.CPUMilli, MemoryBytes: r.MemoryBytes + o.MemoryBytes}
+}
+
+// Exceeds reports whether r is over limits. A zero memory limit means "no memory cap".
+func (r Resources) Exceeds(limits Resources) bool {
+	if r.CPUMilli > limits.CPUMilli {
+		return true
+	}
+	return limits.MemoryBytes > 0 && r.MemoryBytes > limits.MemoryBytes
+}
+
+// Excess returns how far r is over limits in each dimension (never negative).
+// A zero memory limit means "no memory cap".
+func (r Resources) Excess(limits Resources) Resources {
+	var out Resources
+	if r.CPUMilli > limits.CPUMilli {
+		out.CPUMilli = r.CPUMilli - limits.CPUMilli
+	}
+	if limits.MemoryBytes > 0 && r.MemoryBytes > limits.MemoryBytes {
+		out.MemoryBytes = r.MemoryBytes - limits.MemoryBytes
+	}
+	return out
+}
+
+// Covers reports whether r is at least o in every dimension.
+func (r Resources) Covers(o Resources) bool {
+	return r.CPUMilli >= o.CPUMilli && r.MemoryBytes >= o.MemoryBytes
+}
+
+// PodResources sums the limits of all containers of a pod.
+func PodResources(pod *corev1.Pod) Resources {
+	var total Resources
+	for _, c := range pod.Spec.Containers {
+		if cpu := c.Resources.Limits.Cpu(); cpu != nil {
+			total.CPUMilli += cpu.MilliValue()
+		}
+		if mem := c.Resources.Limits.Memory(); mem != nil {
+			total.MemoryBytes += mem.Value()
+		}
+	}
+	return total
+}
+
+// IsActive reports whether a pod still reserves resources (completed/failed pods don't).
+func IsActive(pod *corev1.Pod) bool {
+	return pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed
+}
+
+// Usage sums the limits of all active pods in the list.
+func Usage(pods []corev1.Pod) Resources {
+	var total Resources
+	for i := range pods {
+		if !IsActive(&pods[i]) {
+			continue
+		}
+		total = total.Add(PodResources(&pods[i]))
+	}
+	return total
+}
+
+// Limits parses MaxCpuLimit and MaxMemoryLimit. MemoryBytes is 0 when no memory cap is set.
+func Limits(spec *finopsv1.ProjectBudgetSpec) (Resources, error) {
+	return parseResources(spec.MaxCpuLimit, spec.MaxMemoryLimit)
+}
+
+// parseResources converts a pair of CPU/Memory quantities. Empty strings count as zero.
+func parseResources(cpu, memory string) (Resources, error) {
+	var out Resources
+	if cpu != "" {
+		q, err := resource.ParseQuantity(cpu)
+		if err != nil {
+			return Resources{}, err
+		}
+		out.CPUMilli = q.MilliValue()
+	}
+	if memory != "" {
+		q, err := resource.ParseQuantity(memory)
+		if err != nil {
+			return Resources{}, err
+		}
+		out.MemoryBytes = q.Value()
+	}
+	return out, nil
+}
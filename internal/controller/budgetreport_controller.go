@@ -0,0 +1,190 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/report"
+)
+
+// defaultReportRefreshInterval is how often an open report is regenerated when spec.refreshInterval is unset
+const defaultReportRefreshInterval = time.Hour
+
+// reportFiles are the keys of the report ConfigMap and their formats
+var reportFiles = map[string]report.Format{
+	"report.csv":  report.FormatCSV,
+	"report.json": report.FormatJSON,
+	"report.md":   report.FormatMarkdown,
+}
+
+// BudgetReportReconciler computes the showback reports of BudgetReports
+type BudgetReportReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// Clock decides the default range and accrues usage until now. Defaults to the real clock.
+	Clock clock.PassiveClock
+}
+
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=budgetreports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=budgetreports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=projectbudgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=costmodels,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
+
+// Reconcile aggregates the consumption recorded by the ProjectBudgets of the report namespace
+// over its range, and publishes it in the status and, rendered, in a ConfigMap of the same name.
+// Reports whose range is still open are regenerated every refreshInterval; closed ones are kept
+// as computed, since the samples they were interpolated from age out of the budget status.
+func (r *BudgetReportReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var br finopsv1.BudgetReport
+	if err := r.Get(ctx, req.NamespacedName, &br); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	now := time.Now()
+	if r.Clock != nil {
+		now = r.Clock.Now()
+	}
+	start, end := report.MonthStart(now), now
+	if br.Spec.Start != nil {
+		start = br.Spec.Start.Time
+	}
+	if br.Spec.End != nil && br.Spec.End.Time.Before(now) {
+		end = br.Spec.End.Time
+	}
+	if start.After(now) {
+		// Nothing to report yet: come back when the range starts
+		return ctrl.Result{RequeueAfter: start.Sub(now)}, nil
+	}
+	if !end.After(start) {
+		logger.Info("BudgetReport range is empty, nothing to report", "start", start, "end", end)
+		return ctrl.Result{}, nil
+	}
+	closed := br.Spec.End != nil && !br.Spec.End.After(now)
+
+	upToDate := closed && br.Status.ObservedGeneration == br.Generation &&
+		br.Status.End != nil && br.Status.End.Time.Equal(end)
+	if !upToDate {
+		selector := labels.Everything()
+		if br.Spec.BudgetSelector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(br.Spec.BudgetSelector); err != nil {
+				logger.Error(err, "Invalid budget selector in CRD")
+				return ctrl.Result{}, nil // Does not retry if the format is invalid
+			}
+		}
+		budgets, err := report.Load(ctx, r.Client, br.Namespace, selector)
+		if err != nil {
+			logger.Error(err, "Failed to load the budgets of the report")
+			return ctrl.Result{}, err
+		}
+		showback, err := report.Build(budgets, report.Options{Start: start, End: end, GroupBy: br.Spec.GroupBy, Now: now})
+		if err != nil {
+			logger.Error(err, "Failed to build the report")
+			return ctrl.Result{}, err
+		}
+
+		br.Status = finopsv1.BudgetReportStatus{
+			Start:              &metav1.Time{Time: start},
+			End:                &metav1.Time{Time: end},
+			GeneratedAt:        &metav1.Time{Time: now},
+			ObservedGeneration: br.Generation,
+			Budgets:            showback.Budgets,
+			Groups:             showback.Groups,
+			ConfigMapName:      br.Name,
+		}
+		if err := r.Status().Update(ctx, &br); err != nil {
+			logger.Error(err, "Failed to update BudgetReport status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The ConfigMap is rendered from the status, so it can be restored once the report is closed
+	if err := r.publishReport(ctx, &br); err != nil {
+		logger.Error(err, "Failed to publish the report ConfigMap")
+		return ctrl.Result{}, err
+	}
+
+	if closed {
+		return ctrl.Result{}, nil
+	}
+	interval := br.Spec.RefreshInterval.Duration
+	if interval <= 0 {
+		interval = defaultReportRefreshInterval
+	}
+	if br.Spec.End != nil && br.Spec.End.Sub(now) < interval {
+		interval = br.Spec.End.Sub(now)
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// publishReport writes the CSV, JSON and Markdown renderings of the report status into a
+// ConfigMap owned by the report.
+func (r *BudgetReportReconciler) publishReport(ctx context.Context, br *finopsv1.BudgetReport) error {
+	showback := &report.Showback{
+		Start:   br.Status.Start.Time,
+		End:     br.Status.End.Time,
+		GroupBy: br.Spec.GroupBy,
+		Budgets: br.Status.Budgets,
+		Groups:  br.Status.Groups,
+	}
+	data := make(map[string]string, len(reportFiles))
+	for key, format := range reportFiles {
+		var out bytes.Buffer
+		if err := report.Write(&out, format, showback); err != nil {
+			return err
+		}
+		data[key] = out.String()
+	}
+
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: br.Status.ConfigMapName, Namespace: br.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
+		cm.Data = data
+		return controllerutil.SetControllerReference(br, cm, r.Scheme)
+	})
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BudgetReportReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Spec changes only: open reports are refreshed with RequeueAfter, and the status update
+		// of every pass would otherwise rebuild them straight away
+		For(&finopsv1.BudgetReport{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&corev1.ConfigMap{}).
+		Named("budgetreport").
+		Complete(r)
+}
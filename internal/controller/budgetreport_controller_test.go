@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+var _ = Describe("BudgetReport Controller", func() {
+	ctx := context.Background()
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	key := types.NamespacedName{Name: "showback", Namespace: "finops"}
+
+	var c client.Client
+	var reconciler *BudgetReportReconciler
+
+	BeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(s)).To(Succeed())
+		Expect(finopsv1.AddToScheme(s)).To(Succeed())
+
+		c = fake.NewClientBuilder().WithScheme(s).WithStatusSubresource(&finopsv1.BudgetReport{}).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-alpha", Labels: map[string]string{"cost-center": "1001"}}},
+			&finopsv1.CostModel{
+				ObjectMeta: metav1.ObjectMeta{Name: finopsv1.DefaultCostModelName},
+				Spec:       finopsv1.CostModelSpec{Currency: "USD", CpuHourPrice: "0.05", MemoryGiBHourPrice: "0.01"},
+			},
+			&finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-alpha-budget", Namespace: "finops"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "team-alpha"},
+				Status: finopsv1.ProjectBudgetStatus{Consumption: &finopsv1.ConsumptionStatus{
+					PeriodStart:    metav1.Time{Time: march},
+					CpuCoreHours:   "80.000",
+					MemoryGiBHours: "160.000",
+					LastSampleTime: metav1.Time{Time: now},
+				}},
+			},
+		).Build()
+		reconciler = &BudgetReportReconciler{Client: c, Scheme: c.Scheme(), Clock: clocktesting.NewFakePassiveClock(now)}
+	})
+
+	It("should report the current month to date and refresh it", func() {
+		Expect(c.Create(ctx, &finopsv1.BudgetReport{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec:       finopsv1.BudgetReportSpec{GroupBy: []string{"cost-center"}},
+		})).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(time.Hour))
+
+		var br finopsv1.BudgetReport
+		Expect(c.Get(ctx, key, &br)).To(Succeed())
+		Expect(br.Status.Start.Time).To(BeTemporally("==", march))
+		Expect(br.Status.End.Time).To(BeTemporally("==", now))
+		Expect(br.Status.Budgets).To(Equal([]finopsv1.BudgetUsage{{
+			Budget: "team-alpha-budget", Namespace: "team-alpha", Labels: map[string]string{"cost-center": "1001"},
+			CpuCoreHours: "80.000", MemoryGiBHours: "160.000", Cost: "5.60", Currency: "USD",
+		}}))
+		Expect(br.Status.Groups).To(HaveLen(1))
+
+		var cm corev1.ConfigMap
+		Expect(c.Get(ctx, key, &cm)).To(Succeed())
+		Expect(cm.OwnerReferences).To(HaveLen(1))
+		Expect(cm.Data).To(HaveKeyWithValue("report.csv", ContainSubstring("team-alpha-budget,team-alpha,1001,80.000,160.000,5.60,USD,false")))
+		Expect(cm.Data).To(HaveKeyWithValue("report.md", ContainSubstring("| 1001 | 1 | 80.000 | 160.000 | 5.60 USD |")))
+		Expect(cm.Data).To(HaveKey("report.json"))
+	})
+
+	It("should wait for a range that starts in the future", func() {
+		start := metav1.NewTime(now.Add(14 * time.Hour))
+		Expect(c.Create(ctx, &finopsv1.BudgetReport{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec:       finopsv1.BudgetReportSpec{Start: &start},
+		})).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(14 * time.Hour))
+
+		var br finopsv1.BudgetReport
+		Expect(c.Get(ctx, key, &br)).To(Succeed())
+		Expect(br.Status.GeneratedAt).To(BeNil())
+	})
+
+	It("should keep a closed report as computed", func() {
+		end := metav1.NewTime(march.Add(48 * time.Hour))
+		Expect(c.Create(ctx, &finopsv1.BudgetReport{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec:       finopsv1.BudgetReportSpec{Start: &metav1.Time{Time: march}, End: &end},
+		})).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		var br finopsv1.BudgetReport
+		Expect(c.Get(ctx, key, &br)).To(Succeed())
+		Expect(br.Status.Budgets).To(HaveLen(1))
+		Expect(br.Status.Budgets[0].CpuCoreHours).To(Equal("46.829")) // 80 core-hours over 82h, interpolated
+		generatedAt := br.Status.GeneratedAt
+
+		By("not recomputing it later, once its samples may have aged out")
+		reconciler.Clock = clocktesting.NewFakePassiveClock(now.Add(24 * time.Hour))
+		_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, key, &br)).To(Succeed())
+		Expect(br.Status.GeneratedAt.Time).To(BeTemporally("==", generatedAt.Time))
+
+		By("restoring its ConfigMap from the status")
+		Expect(c.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}})).To(Succeed())
+		_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		var cm corev1.ConfigMap
+		Expect(c.Get(ctx, key, &cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue("report.csv", ContainSubstring(",46.829,")))
+	})
+})
@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// BudgetViolationReconciler garbage collects BudgetViolations once their spec.expiresAt has passed
+type BudgetViolationReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// Clock decides when records expire. Defaults to the real clock.
+	Clock clock.PassiveClock
+}
+
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=budgetviolations,verbs=get;list;watch;delete
+
+// Reconcile deletes an expired BudgetViolation, or comes back when it expires.
+func (r *BudgetViolationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var violation finopsv1.BudgetViolation
+	if err := r.Get(ctx, req.NamespacedName, &violation); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	now := time.Now()
+	if r.Clock != nil {
+		now = r.Clock.Now()
+	}
+	if remaining := violation.Spec.ExpiresAt.Sub(now); remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	log.FromContext(ctx).Info("Deleting expired BudgetViolation", "name", violation.Name, "namespace", violation.Namespace)
+	if err := r.Delete(ctx, &violation); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BudgetViolationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&finopsv1.BudgetViolation{}).
+		Named("budgetviolation").
+		Complete(r)
+}
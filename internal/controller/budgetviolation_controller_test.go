@@ -0,0 +1,105 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+var _ = Describe("BudgetViolation Controller", func() {
+	ctx := context.Background()
+	start := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	newFakeClient := func(objs ...client.Object) client.Client {
+		s := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(s)).To(Succeed())
+		Expect(finopsv1.AddToScheme(s)).To(Succeed())
+		return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+	}
+
+	It("should keep a violation until it expires, then delete it", func() {
+		key := types.NamespacedName{Name: "team-a-budget-x7k2p", Namespace: "team-a"}
+		c := newFakeClient(&finopsv1.BudgetViolation{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: finopsv1.BudgetViolationSpec{
+				BudgetKind: "ProjectBudget",
+				BudgetName: "team-a-budget",
+				Source:     finopsv1.ViolationSourceAdmission,
+				Action:     finopsv1.ViolationDenied,
+				ExpiresAt:  metav1.NewTime(start.Add(24 * time.Hour)),
+			},
+		})
+		fakeClock := clocktesting.NewFakePassiveClock(start)
+		r := &BudgetViolationReconciler{Client: c, Clock: fakeClock}
+
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(24 * time.Hour))
+		Expect(c.Get(ctx, key, &finopsv1.BudgetViolation{})).To(Succeed())
+
+		fakeClock.SetTime(start.Add(24 * time.Hour))
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errors.IsNotFound(c.Get(ctx, key, &finopsv1.BudgetViolation{}))).To(BeTrue())
+	})
+
+	It("should record an overrun in the team namespace with its figures", func() {
+		c := newFakeClient()
+		r := &ProjectBudgetReconciler{Client: c, ViolationTTL: 7 * 24 * time.Hour}
+		pb := &finopsv1.ProjectBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-b-budget", Namespace: "default"},
+			Spec:       finopsv1.ProjectBudgetSpec{TeamName: "team-b"},
+		}
+		meta.SetStatusCondition(&pb.Status.Conditions, metav1.Condition{
+			Type:    finopsv1.ConditionOverBudget,
+			Status:  metav1.ConditionTrue,
+			Reason:  reasonOverBudget,
+			Message: "Namespace team-b is over budget",
+		})
+
+		r.recordOverrun(ctx, pb, budget.Resources{CPUMilli: 1500, MemoryBytes: 1 << 30},
+			budget.Resources{CPUMilli: 1000, MemoryBytes: 2 << 30}, start)
+
+		var violations finopsv1.BudgetViolationList
+		Expect(c.List(ctx, &violations, client.InNamespace("team-b"),
+			client.MatchingLabels{finopsv1.BudgetNameLabel: "team-b-budget"})).To(Succeed())
+		Expect(violations.Items).To(HaveLen(1))
+		spec := violations.Items[0].Spec
+		Expect(spec.Source).To(Equal(finopsv1.ViolationSourceController))
+		Expect(spec.Action).To(Equal(finopsv1.ViolationDetected))
+		Expect(spec.Message).To(Equal("Namespace team-b is over budget"))
+		Expect(spec.Resources).To(Equal([]finopsv1.ViolatedResource{{Resource: "cpu", Used: "1500m", Limit: "1"}}))
+		Expect(spec.ExpiresAt.Time.Equal(start.Add(7 * 24 * time.Hour))).To(BeTrue())
+	})
+})
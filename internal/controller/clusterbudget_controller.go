@@ -0,0 +1,185 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// ClusterBudgetReconciler reconciles a ClusterBudget object
+type ClusterBudgetReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// Clock stamps LastCheckTime. Defaults to the real clock.
+	Clock clock.PassiveClock
+}
+
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=clusterbudgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=clusterbudgets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile sums the limits of the pods of every selected namespace and reports them in the
+// status of the ClusterBudget. Admission is done by the pod webhook; the controller only observes.
+func (r *ClusterBudgetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// 1. Get the ClusterBudget instance that triggered this event
+	var clusterBudget finopsv1.ClusterBudget
+	if err := r.Get(ctx, req.NamespacedName, &clusterBudget); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// 2. Find the namespaces it selects
+	selector, err := budget.NamespaceSelector(&clusterBudget.Spec)
+	if err != nil {
+		logger.Error(err, "Invalid namespace selector in CRD")
+		return ctrl.Result{}, nil // Does not retry if the format is invalid
+	}
+	limits, err := budget.ClusterLimits(&clusterBudget.Spec)
+	if err != nil {
+		logger.Error(err, "Invalid limits in CRD")
+		return ctrl.Result{}, nil
+	}
+	var namespaceList corev1.NamespaceList
+	if err := r.List(ctx, &namespaceList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		logger.Error(err, "Failed to list namespaces")
+		return ctrl.Result{}, err
+	}
+	namespaces := budget.SelectedNamespaces(selector, namespaceList.Items)
+
+	// 3. Calculate current usage across all of them
+	var usage budget.Resources
+	for _, ns := range namespaces {
+		var podList corev1.PodList
+		if err := r.List(ctx, &podList, client.InNamespace(ns)); err != nil {
+			logger.Error(err, "Failed to list pods in namespace", "namespace", ns)
+			return ctrl.Result{}, err
+		}
+		usage = usage.Add(budget.Usage(podList.Items))
+	}
+
+	// 4. Usage may be over the limit when namespaces get (re)labelled into the selector
+	condition := metav1.Condition{
+		Type:               finopsv1.ConditionOverBudget,
+		Status:             metav1.ConditionFalse,
+		Reason:             "WithinBudget",
+		Message:            fmt.Sprintf("Selected namespaces use %dm of %dm CPU", usage.CPUMilli, limits.CPUMilli),
+		ObservedGeneration: clusterBudget.Generation,
+	}
+	if usage.Exceeds(limits) {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "UsageOverLimit"
+		if !meta.IsStatusConditionTrue(clusterBudget.Status.Conditions, finopsv1.ConditionOverBudget) {
+			r.Recorder.Event(&clusterBudget, "Warning", "OverBudget", condition.Message)
+		}
+	}
+	meta.SetStatusCondition(&clusterBudget.Status.Conditions, condition)
+
+	// 5. Update the ClusterBudget status
+	clusterBudget.Status.CurrentCpuUsage = fmt.Sprintf("%dm", usage.CPUMilli)
+	clusterBudget.Status.CurrentMemoryUsage = resource.NewQuantity(usage.MemoryBytes, resource.BinarySI).String()
+	clusterBudget.Status.Namespaces = namespaces
+	clusterBudget.Status.LastCheckTime = r.now().UTC().Format(time.RFC3339)
+
+	if err := r.Status().Update(ctx, &clusterBudget); err != nil {
+		logger.Error(err, "Failed to update ClusterBudget status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// now returns the current time according to the configured clock.
+func (r *ClusterBudgetReconciler) now() time.Time {
+	if r.Clock == nil {
+		return time.Now()
+	}
+	return r.Clock.Now()
+}
+
+// clusterBudgetsForPod maps a Pod event to the ClusterBudgets selecting its namespace.
+func (r *ClusterBudgetReconciler) clusterBudgetsForPod(ctx context.Context, obj client.Object) []reconcile.Request {
+	var ns corev1.Namespace
+	if err := r.Get(ctx, types.NamespacedName{Name: obj.GetNamespace()}, &ns); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to get namespace for pod event", "namespace", obj.GetNamespace())
+		return nil
+	}
+	return r.clusterBudgetsFor(ctx, labels.Set(ns.Labels))
+}
+
+// clusterBudgetsForNamespace maps a Namespace event to every ClusterBudget: a label change may
+// move the namespace out of a selector as well as into one.
+func (r *ClusterBudgetReconciler) clusterBudgetsForNamespace(ctx context.Context, _ client.Object) []reconcile.Request {
+	return r.clusterBudgetsFor(ctx, nil)
+}
+
+// clusterBudgetsFor returns the ClusterBudgets selecting namespaces with the given labels
+// (all of them when nsLabels is nil).
+func (r *ClusterBudgetReconciler) clusterBudgetsFor(ctx context.Context, nsLabels labels.Labels) []reconcile.Request {
+	var budgetList finopsv1.ClusterBudgetList
+	if err := r.List(ctx, &budgetList); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list cluster budgets")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, b := range budgetList.Items {
+		if nsLabels != nil {
+			selector, err := budget.NamespaceSelector(&b.Spec)
+			if err != nil || !selector.Matches(nsLabels) {
+				continue
+			}
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: b.Name}})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterBudgetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Spec changes only: the status update of every pass would trigger the next one
+		For(&finopsv1.ClusterBudget{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.clusterBudgetsForPod)).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.clusterBudgetsForNamespace)).
+		Named("clusterbudget").
+		Complete(r)
+}
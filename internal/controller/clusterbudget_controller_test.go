@@ -0,0 +1,100 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+var _ = Describe("ClusterBudget Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "sandboxes"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName}
+
+		BeforeEach(func() {
+			By("creating two sandbox namespaces and one outside the selector")
+			for name, env := range map[string]string{"sandbox-one": "sandbox", "sandbox-two": "sandbox", "not-a-sandbox": "prod"} {
+				ns := &corev1.Namespace{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, ns)
+				if err != nil && errors.IsNotFound(err) {
+					Expect(k8sClient.Create(ctx, &corev1.Namespace{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"env": env}},
+					})).To(Succeed())
+				}
+			}
+			Expect(k8sClient.Create(ctx, newLimitedPod("one", "sandbox-one", "1"))).To(Succeed())
+			Expect(k8sClient.Create(ctx, newLimitedPod("two", "sandbox-two", "2"))).To(Succeed())
+			Expect(k8sClient.Create(ctx, newLimitedPod("other", "not-a-sandbox", "8"))).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &finopsv1.ClusterBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName},
+				Spec: finopsv1.ClusterBudgetSpec{
+					NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "sandbox"}},
+					MaxCpuLimit:       "2",
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &finopsv1.ClusterBudget{ObjectMeta: metav1.ObjectMeta{Name: resourceName}})).To(Succeed())
+			for name, ns := range map[string]string{"one": "sandbox-one", "two": "sandbox-two", "other": "not-a-sandbox"} {
+				Expect(k8sClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns}})).To(Succeed())
+			}
+		})
+
+		It("should sum the usage of the selected namespaces only", func() {
+			recorder := record.NewFakeRecorder(10)
+			controllerReconciler := &ClusterBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &finopsv1.ClusterBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.CurrentCpuUsage).To(Equal("3000m"))
+			Expect(updated.Status.Namespaces).To(ConsistOf("sandbox-one", "sandbox-two"))
+			Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, finopsv1.ConditionOverBudget)).To(BeTrue())
+			Expect(recorder.Events).To(Receive(ContainSubstring("OverBudget")))
+		})
+
+		It("should enqueue the budgets selecting the namespace of a pod", func() {
+			controllerReconciler := &ClusterBudgetReconciler{Client: k8sClient}
+
+			Expect(controllerReconciler.clusterBudgetsForPod(ctx, newLimitedPod("x", "sandbox-one", "1"))).
+				To(ConsistOf(reconcile.Request{NamespacedName: typeNamespacedName}))
+			Expect(controllerReconciler.clusterBudgetsForPod(ctx, newLimitedPod("x", "not-a-sandbox", "1"))).To(BeEmpty())
+		})
+	})
+})
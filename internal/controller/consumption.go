@@ -0,0 +1,174 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+const (
+	// consumptionSampleInterval bounds the time between two samples when no pod changes
+	consumptionSampleInterval = 15 * time.Minute
+	// maxConsumptionHistory is the number of closed billing periods kept in status
+	maxConsumptionHistory = 12
+	// forecastSampleInterval is the minimum time between two recorded samples
+	forecastSampleInterval = time.Hour
+	// maxForecastSamples is the number of samples kept for forecasting (two days of hourly samples)
+	maxForecastSamples = 48
+)
+
+// reconcileConsumption integrates usage over the billing period and returns when the next
+// sample is due. Usage only changes when pods do (and every pod event triggers a reconcile),
+// so the usage sampled last time is what the namespace held until now.
+func (r *ProjectBudgetReconciler) reconcileConsumption(pb *finopsv1.ProjectBudget, usage budget.Resources,
+	now time.Time) (time.Duration, error) {
+
+	spec := pb.Spec.Accounting
+	if spec == nil {
+		pb.Status.Consumption = nil
+		meta.RemoveStatusCondition(&pb.Status.Conditions, finopsv1.ConditionAllowanceExhausted)
+		meta.RemoveStatusCondition(&pb.Status.Conditions, finopsv1.ConditionProjectedOverrun)
+		projectedSpend.DeletePartialMatch(prometheus.Labels{"namespace": pb.Namespace, "budget": pb.Name})
+		return 0, nil
+	}
+
+	periodStart := budget.PeriodStart(spec.Period, now)
+	status := pb.Status.Consumption
+	var consumed budget.Consumption
+	var samples []finopsv1.ConsumptionSample
+
+	if status != nil {
+		previous, err := budget.ParseConsumption(status)
+		if err != nil {
+			return 0, err
+		}
+		sampled := budget.Resources{CPUMilli: status.SampledCpuMilli, MemoryBytes: status.SampledMemoryBytes}
+		lastSample := status.LastSampleTime.Time
+
+		if status.PeriodStart.Time.Equal(periodStart) {
+			consumed = previous.Accrue(sampled, now.Sub(lastSample))
+			samples = status.Samples
+		} else {
+			// The period is over: close it and start again from zero
+			end := budget.PeriodEnd(spec.Period, status.PeriodStart.Time)
+			closed := previous.Accrue(sampled, end.Sub(lastSample))
+			archivePeriod(pb, status.PeriodStart.Time, end, closed)
+			r.Recorder.Eventf(pb, "Normal", "BillingPeriodReset",
+				"Billing period starting %s closed with %s CPU core-hours and %s Memory GiB-hours",
+				status.PeriodStart.UTC().Format(time.RFC3339), budget.FormatHours(closed.CPUCoreHours),
+				budget.FormatHours(closed.MemoryGiBHours))
+
+			consumed = consumed.Accrue(sampled, now.Sub(maxTime(lastSample, periodStart)))
+		}
+	}
+
+	pb.Status.Consumption = &finopsv1.ConsumptionStatus{
+		PeriodStart:        metav1.Time{Time: periodStart},
+		LastSampleTime:     metav1.Time{Time: now},
+		SampledCpuMilli:    usage.CPUMilli,
+		SampledMemoryBytes: usage.MemoryBytes,
+		Samples:            recordSample(samples, consumed, now),
+	}
+	budget.SetConsumption(pb.Status.Consumption, consumed)
+
+	if err := r.setAllowanceCondition(pb, consumed); err != nil {
+		return 0, err
+	}
+	if err := r.reconcileForecast(pb, consumed, now); err != nil {
+		return 0, err
+	}
+
+	periodEnd := budget.PeriodEnd(spec.Period, periodStart)
+	return earliestRequeue(consumptionSampleInterval, periodEnd.Sub(now)), nil
+}
+
+// recordSample appends the current totals to the samples at most once per forecastSampleInterval,
+// keeping the most recent ones.
+func recordSample(samples []finopsv1.ConsumptionSample, consumed budget.Consumption, now time.Time) []finopsv1.ConsumptionSample {
+	if n := len(samples); n > 0 && now.Sub(samples[n-1].Time.Time) < forecastSampleInterval {
+		return samples
+	}
+	samples = append(samples, finopsv1.ConsumptionSample{
+		Time:           metav1.Time{Time: now},
+		CpuCoreHours:   budget.FormatHours(consumed.CPUCoreHours),
+		MemoryGiBHours: budget.FormatHours(consumed.MemoryGiBHours),
+	})
+	if extra := len(samples) - maxForecastSamples; extra > 0 {
+		samples = samples[extra:]
+	}
+	return samples
+}
+
+// archivePeriod appends a closed period to the history, keeping the most recent ones.
+func archivePeriod(pb *finopsv1.ProjectBudget, start, end time.Time, consumed budget.Consumption) {
+	pb.Status.ConsumptionHistory = append(pb.Status.ConsumptionHistory, finopsv1.PeriodConsumption{
+		Start:          metav1.Time{Time: start},
+		End:            metav1.Time{Time: end},
+		CpuCoreHours:   budget.FormatHours(consumed.CPUCoreHours),
+		MemoryGiBHours: budget.FormatHours(consumed.MemoryGiBHours),
+	})
+	if extra := len(pb.Status.ConsumptionHistory) - maxConsumptionHistory; extra > 0 {
+		pb.Status.ConsumptionHistory = pb.Status.ConsumptionHistory[extra:]
+	}
+}
+
+// setAllowanceCondition reports whether an allowance of the period is used up, with an event
+// the first time it happens.
+func (r *ProjectBudgetReconciler) setAllowanceCondition(pb *finopsv1.ProjectBudget, consumed budget.Consumption) error {
+	allowance, err := budget.Allowance(pb.Spec.Accounting)
+	if err != nil {
+		return err
+	}
+
+	condition := metav1.Condition{
+		Type:               finopsv1.ConditionAllowanceExhausted,
+		Status:             metav1.ConditionFalse,
+		Reason:             "WithinAllowance",
+		Message:            "Consumption is within the allowance of the period",
+		ObservedGeneration: pb.Generation,
+	}
+	if dimension := consumed.ExhaustedBy(allowance); dimension != "" {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = dimension + "AllowanceExhausted"
+		condition.Message = fmt.Sprintf("%s allowance of the period is used up (%s CPU core-hours, %s Memory GiB-hours consumed)",
+			dimension, budget.FormatHours(consumed.CPUCoreHours), budget.FormatHours(consumed.MemoryGiBHours))
+		if pb.Spec.Accounting.BlockWhenExhausted {
+			condition.Message += "; new pods are denied until the period resets"
+		}
+		if !meta.IsStatusConditionTrue(pb.Status.Conditions, finopsv1.ConditionAllowanceExhausted) {
+			r.Recorder.Event(pb, "Warning", "AllowanceExhausted", condition.Message)
+		}
+	}
+	meta.SetStatusCondition(&pb.Status.Conditions, condition)
+	return nil
+}
+
+// maxTime returns the later of a and b.
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
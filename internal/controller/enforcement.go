@@ -0,0 +1,395 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// +kubebuilder:rbac:groups="",resources=pods/eviction,verbs=create
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;update;patch
+
+// workloadKey identifies a scalable workload (Deployment or StatefulSet) in the team namespace.
+type workloadKey struct {
+	Kind string
+	Name string
+}
+
+// enforcementAction returns the configured action, defaulting to None.
+func enforcementAction(pb *finopsv1.ProjectBudget) finopsv1.EnforcementAction {
+	if pb.Spec.Enforcement == nil || pb.Spec.Enforcement.Action == "" {
+		return finopsv1.EnforcementNone
+	}
+	return pb.Spec.Enforcement.Action
+}
+
+// planEnforcement computes the steps needed to reclaim excess from the given pods.
+// Pods already being deleted are ignored: they are on their way out.
+func (r *ProjectBudgetReconciler) planEnforcement(ctx context.Context, pb *finopsv1.ProjectBudget,
+	pods []corev1.Pod, excess budget.Resources) ([]finopsv1.PlannedAction, error) {
+
+	var candidates []corev1.Pod
+	for _, p := range pods {
+		if !budget.IsActive(&p) {
+			continue
+		}
+		if p.DeletionTimestamp != nil {
+			// Already terminating: its resources are about to be released
+			freed := budget.PodResources(&p)
+			excess.CPUMilli = max(0, excess.CPUMilli-freed.CPUMilli)
+			excess.MemoryBytes = max(0, excess.MemoryBytes-freed.MemoryBytes)
+			continue
+		}
+		candidates = append(candidates, p)
+	}
+	if excess == (budget.Resources{}) {
+		return nil, nil
+	}
+
+	action := enforcementAction(pb)
+	switch action {
+	case finopsv1.EnforcementEvictNewest, finopsv1.EnforcementScaleDownWorkloads:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return newerThan(&candidates[i], &candidates[j])
+		})
+	case finopsv1.EnforcementEvictLowestPriority:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			pi, pj := podPriority(&candidates[i]), podPriority(&candidates[j])
+			if pi != pj {
+				return pi < pj
+			}
+			return newerThan(&candidates[i], &candidates[j])
+		})
+	default:
+		return nil, nil
+	}
+
+	if action == finopsv1.EnforcementScaleDownWorkloads {
+		return r.planScaleDown(ctx, pb.Spec.TeamName, candidates, excess)
+	}
+	return r.planEvictions(ctx, pb.Spec.TeamName, candidates, excess)
+}
+
+// planEvictions picks pods in order until excess is covered, skipping pods whose
+// PodDisruptionBudget does not allow any more disruptions.
+func (r *ProjectBudgetReconciler) planEvictions(ctx context.Context, namespace string,
+	candidates []corev1.Pod, excess budget.Resources) ([]finopsv1.PlannedAction, error) {
+
+	pdbList, allowed, err := r.disruptionBudgets(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan []finopsv1.PlannedAction
+	var reclaimed budget.Resources
+	for i := range candidates {
+		if reclaimed.Covers(excess) {
+			break
+		}
+		pod := &candidates[i]
+
+		pdbs := matchingPDBs(pod, pdbList)
+		if !disruptionAllowed(pdbs, allowed) {
+			log.FromContext(ctx).Info("Skipping pod protected by PodDisruptionBudget", "pod", pod.Name)
+			continue
+		}
+		for _, name := range pdbs {
+			allowed[name]--
+		}
+
+		freed := budget.PodResources(pod)
+		reclaimed = reclaimed.Add(freed)
+		plan = append(plan, plannedAction(finopsv1.PlannedEvict, "Pod", pod.Name, nil, freed))
+	}
+	return plan, nil
+}
+
+// planScaleDown removes one replica per selected pod from the Deployment or StatefulSet owning it.
+// Bare pods (and pods of other controllers) cannot be scaled and are skipped. Scaling bypasses the
+// Eviction API, so pods whose PodDisruptionBudget does not allow any more disruptions are skipped too.
+func (r *ProjectBudgetReconciler) planScaleDown(ctx context.Context, namespace string,
+	candidates []corev1.Pod, excess budget.Resources) ([]finopsv1.PlannedAction, error) {
+
+	pdbs, allowed, err := r.disruptionBudgets(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]workloadKey, len(candidates))
+	running := map[workloadKey]int32{}
+	for i := range candidates {
+		key, ok, err := r.workloadOf(ctx, &candidates[i])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			owners[candidates[i].Name] = key
+			running[key]++
+		}
+	}
+
+	replicas := map[workloadKey]int32{}
+	for key := range running {
+		current, err := r.workloadReplicas(ctx, namespace, key)
+		if err != nil {
+			return nil, err
+		}
+		replicas[key] = current
+	}
+
+	var order []workloadKey
+	freedBy := map[workloadKey]budget.Resources{}
+	var reclaimed budget.Resources
+	for i := range candidates {
+		if reclaimed.Covers(excess) {
+			break
+		}
+		key, ok := owners[candidates[i].Name]
+		if !ok {
+			continue
+		}
+		// Pods above the current replica count are already being removed by their controller
+		if running[key] > replicas[key] {
+			running[key]--
+			reclaimed = reclaimed.Add(budget.PodResources(&candidates[i]))
+			continue
+		}
+		if replicas[key] == 0 {
+			continue
+		}
+		matching := matchingPDBs(&candidates[i], pdbs)
+		if !disruptionAllowed(matching, allowed) {
+			log.FromContext(ctx).Info("Not scaling down pod protected by PodDisruptionBudget", "pod", candidates[i].Name)
+			continue
+		}
+		for _, name := range matching {
+			allowed[name]--
+		}
+
+		if _, seen := freedBy[key]; !seen {
+			order = append(order, key)
+		}
+		running[key]--
+		replicas[key]--
+		freed := budget.PodResources(&candidates[i])
+		freedBy[key] = freedBy[key].Add(freed)
+		reclaimed = reclaimed.Add(freed)
+	}
+
+	plan := make([]finopsv1.PlannedAction, 0, len(order))
+	for _, key := range order {
+		target := replicas[key]
+		plan = append(plan, plannedAction(finopsv1.PlannedScaleDown, key.Kind, key.Name, &target, freedBy[key]))
+	}
+	return plan, nil
+}
+
+// executeEnforcement carries out a plan and returns the steps done. Evictions refused because of a
+// PodDisruptionBudget are skipped; the next reconciliation will plan again with fresh data.
+func (r *ProjectBudgetReconciler) executeEnforcement(ctx context.Context, pb *finopsv1.ProjectBudget,
+	plan []finopsv1.PlannedAction) ([]finopsv1.PlannedAction, error) {
+
+	logger := log.FromContext(ctx)
+	namespace := pb.Spec.TeamName
+	var executed []finopsv1.PlannedAction
+	var errs []error
+
+	for _, step := range plan {
+		switch step.Action {
+		case finopsv1.PlannedEvict:
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: step.Name, Namespace: namespace}}
+			eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: step.Name, Namespace: namespace}}
+			err := r.SubResource("eviction").Create(ctx, pod, eviction)
+			switch {
+			case apierrors.IsTooManyRequests(err):
+				logger.Info("Eviction refused by PodDisruptionBudget", "pod", step.Name)
+				continue
+			case apierrors.IsNotFound(err):
+				continue
+			case err != nil:
+				errs = append(errs, fmt.Errorf("evicting pod %s: %w", step.Name, err))
+				continue
+			}
+			r.Recorder.Eventf(pb, "Warning", "PodEvicted", "Evicted pod %s/%s to reclaim %s CPU, %s memory",
+				namespace, step.Name, step.CpuReclaimed, step.MemoryReclaimed)
+			executed = append(executed, step)
+
+		case finopsv1.PlannedScaleDown:
+			if err := r.scaleWorkload(ctx, namespace, workloadKey{Kind: step.Kind, Name: step.Name}, *step.Replicas); err != nil {
+				errs = append(errs, fmt.Errorf("scaling %s %s: %w", step.Kind, step.Name, err))
+				continue
+			}
+			r.Recorder.Eventf(pb, "Warning", "WorkloadScaledDown", "Scaled %s %s/%s down to %d replicas to reclaim %s CPU, %s memory",
+				step.Kind, namespace, step.Name, *step.Replicas, step.CpuReclaimed, step.MemoryReclaimed)
+			executed = append(executed, step)
+		}
+	}
+	return executed, errors.Join(errs...)
+}
+
+// workloadOf resolves the Deployment (through its ReplicaSet) or StatefulSet controlling a pod.
+func (r *ProjectBudgetReconciler) workloadOf(ctx context.Context, pod *corev1.Pod) (workloadKey, bool, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return workloadKey{}, false, nil
+	}
+
+	switch owner.Kind {
+	case "StatefulSet":
+		return workloadKey{Kind: "StatefulSet", Name: owner.Name}, true, nil
+	case "ReplicaSet":
+		var rs appsv1.ReplicaSet
+		if err := r.Get(ctx, types.NamespacedName{Name: owner.Name, Namespace: pod.Namespace}, &rs); err != nil {
+			return workloadKey{}, false, client.IgnoreNotFound(err)
+		}
+		if rsOwner := metav1.GetControllerOf(&rs); rsOwner != nil && rsOwner.Kind == "Deployment" {
+			return workloadKey{Kind: "Deployment", Name: rsOwner.Name}, true, nil
+		}
+	}
+	return workloadKey{}, false, nil
+}
+
+// workloadReplicas returns the desired replicas of a workload.
+func (r *ProjectBudgetReconciler) workloadReplicas(ctx context.Context, namespace string, key workloadKey) (int32, error) {
+	obj, replicas, err := r.getWorkload(ctx, namespace, key)
+	if err != nil || obj == nil {
+		return 0, client.IgnoreNotFound(err)
+	}
+	if *replicas == nil {
+		return 1, nil
+	}
+	return **replicas, nil
+}
+
+// scaleWorkload sets the desired replicas of a workload.
+func (r *ProjectBudgetReconciler) scaleWorkload(ctx context.Context, namespace string, key workloadKey, target int32) error {
+	obj, replicas, err := r.getWorkload(ctx, namespace, key)
+	if err != nil {
+		return err
+	}
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	*replicas = &target
+	return r.Patch(ctx, obj, patch)
+}
+
+// getWorkload fetches a Deployment or StatefulSet and returns a pointer to its replicas field.
+func (r *ProjectBudgetReconciler) getWorkload(ctx context.Context, namespace string, key workloadKey) (client.Object, **int32, error) {
+	nn := types.NamespacedName{Name: key.Name, Namespace: namespace}
+	switch key.Kind {
+	case "Deployment":
+		var d appsv1.Deployment
+		if err := r.Get(ctx, nn, &d); err != nil {
+			return nil, nil, err
+		}
+		return &d, &d.Spec.Replicas, nil
+	case "StatefulSet":
+		var s appsv1.StatefulSet
+		if err := r.Get(ctx, nn, &s); err != nil {
+			return nil, nil, err
+		}
+		return &s, &s.Spec.Replicas, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported workload kind %q", key.Kind)
+}
+
+// disruptionBudgets lists the PodDisruptionBudgets of the namespace with the disruptions each
+// still allows, to be spent as pods are planned for removal.
+func (r *ProjectBudgetReconciler) disruptionBudgets(ctx context.Context,
+	namespace string) ([]policyv1.PodDisruptionBudget, map[string]int32, error) {
+
+	var pdbList policyv1.PodDisruptionBudgetList
+	if err := r.List(ctx, &pdbList, client.InNamespace(namespace)); err != nil {
+		return nil, nil, err
+	}
+	allowed := make(map[string]int32, len(pdbList.Items))
+	for _, pdb := range pdbList.Items {
+		allowed[pdb.Name] = pdb.Status.DisruptionsAllowed
+	}
+	return pdbList.Items, allowed, nil
+}
+
+// matchingPDBs returns the names of the PodDisruptionBudgets selecting a pod.
+func matchingPDBs(pod *corev1.Pod, pdbs []policyv1.PodDisruptionBudget) []string {
+	var names []string
+	for _, pdb := range pdbs {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			names = append(names, pdb.Name)
+		}
+	}
+	return names
+}
+
+// disruptionAllowed reports whether every PDB in names still allows a disruption.
+func disruptionAllowed(names []string, allowed map[string]int32) bool {
+	for _, name := range names {
+		if allowed[name] <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// plannedAction builds a PlannedAction with human-readable reclaimed amounts.
+func plannedAction(action, kind, name string, replicas *int32, freed budget.Resources) finopsv1.PlannedAction {
+	return finopsv1.PlannedAction{
+		Action:          action,
+		Kind:            kind,
+		Name:            name,
+		Replicas:        replicas,
+		CpuReclaimed:    resource.NewMilliQuantity(freed.CPUMilli, resource.DecimalSI).String(),
+		MemoryReclaimed: resource.NewQuantity(freed.MemoryBytes, resource.BinarySI).String(),
+	}
+}
+
+// newerThan orders pods by creation time, newest first (name breaks ties).
+func newerThan(a, b *corev1.Pod) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return b.CreationTimestamp.Before(&a.CreationTimestamp)
+	}
+	return a.Name > b.Name
+}
+
+// podPriority returns the resolved priority of a pod (0 when unset).
+func podPriority(pod *corev1.Pod) int32 {
+	if pod.Spec.Priority == nil {
+		return 0
+	}
+	return *pod.Spec.Priority
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// newLimitedPod returns a pod in ns with a single container limited to cpu.
+func newLimitedPod(name, ns, cpu string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, Labels: map[string]string{"app": name}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:  "app",
+			Image: "nginx",
+			Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse(cpu),
+			}},
+		}}},
+	}
+}
+
+var _ = Describe("ProjectBudget Enforcement", func() {
+	Context("When a lowered budget evicts the newest pods", func() {
+		const (
+			resourceName = "shrunk-budget"
+			teamName     = "enforced-team"
+		)
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+
+		BeforeEach(func() {
+			By("creating three pods for a budget of two")
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: teamName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			for _, name := range []string{"pod-a", "pod-b", "pod-c"} {
+				Expect(k8sClient.Create(ctx, newLimitedPod(name, teamName, "100m"))).To(Succeed())
+			}
+
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:       teamName,
+					MaxCpuLimit:    "200m",
+					ValidationMode: finopsv1.DryRunMode,
+					Enforcement:    &finopsv1.EnforcementSpec{Action: finopsv1.EnforcementEvictNewest},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(teamName))).To(Succeed())
+			Expect(k8sClient.DeleteAllOf(ctx, &policyv1.PodDisruptionBudget{}, client.InNamespace(teamName))).To(Succeed())
+		})
+
+		It("should only publish the plan in DryRun mode", func() {
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.EnforcementPlan).To(HaveLen(1))
+			Expect(updated.Status.EnforcementPlan[0].Action).To(Equal(finopsv1.PlannedEvict))
+			Expect(updated.Status.EnforcementPlan[0].CpuReclaimed).To(Equal("100m"))
+			Expect(updated.Status.LastEnforcementTime).To(BeNil())
+
+			var pods corev1.PodList
+			Expect(k8sClient.List(ctx, &pods, client.InNamespace(teamName))).To(Succeed())
+			Expect(pods.Items).To(HaveLen(3))
+		})
+
+		It("should evict through the Eviction API, skipping pods protected by a PDB", func() {
+			By("protecting the newest pod with a PodDisruptionBudget that allows no disruption")
+			Expect(k8sClient.Create(ctx, &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "protect-c", Namespace: teamName},
+				Spec: policyv1.PodDisruptionBudgetSpec{
+					MinAvailable: ptr.To(intstr.FromInt32(1)),
+					Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "pod-c"}},
+				},
+			})).To(Succeed())
+
+			budgetObj := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, budgetObj)).To(Succeed())
+			budgetObj.Spec.ValidationMode = finopsv1.EnforceMode
+			Expect(k8sClient.Update(ctx, budgetObj)).To(Succeed())
+
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.EnforcementPlan).To(ConsistOf(HaveField("Name", "pod-b")))
+			Expect(updated.Status.LastEnforcementTime).NotTo(BeNil())
+
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "pod-b", Namespace: teamName}, &corev1.Pod{})
+				return errors.IsNotFound(err)
+			}).Should(BeTrue())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "pod-c", Namespace: teamName}, &corev1.Pod{})).To(Succeed())
+		})
+	})
+
+	Context("When planning a scale-down", func() {
+		const ns = "scale-team"
+		var (
+			objs []client.Object
+			pods []corev1.Pod
+			pb   *finopsv1.ProjectBudget
+		)
+
+		BeforeEach(func() {
+			deploy := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: ns, UID: "deploy-uid"},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](3)},
+			}
+			rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+				Name: "web-123", Namespace: ns, UID: "rs-uid",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "deploy-uid", Controller: ptr.To(true),
+				}},
+			}}
+			objs = []client.Object{deploy, rs}
+			pods = nil
+			for _, name := range []string{"web-123-a", "web-123-b", "web-123-c"} {
+				pod := newLimitedPod(name, ns, "200m")
+				pod.Labels = map[string]string{"app": "web"}
+				pod.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-123", UID: "rs-uid", Controller: ptr.To(true),
+				}}
+				pods = append(pods, *pod)
+			}
+			bare := newLimitedPod("debug", ns, "500m")
+			pods = append(pods, *bare)
+			pb = &finopsv1.ProjectBudget{Spec: finopsv1.ProjectBudgetSpec{
+				TeamName:    ns,
+				Enforcement: &finopsv1.EnforcementSpec{Action: finopsv1.EnforcementScaleDownWorkloads},
+			}}
+		})
+
+		newReconciler := func() *ProjectBudgetReconciler {
+			return &ProjectBudgetReconciler{
+				Client:   fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(objs...).Build(),
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+		}
+
+		It("should lower the replicas of the Deployment owning the newest pods", func() {
+			r := newReconciler()
+
+			plan, err := r.planEnforcement(ctx, pb, pods, budget.Resources{CPUMilli: 300})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(HaveLen(1))
+			Expect(plan[0].Kind).To(Equal("Deployment"))
+			Expect(plan[0].Name).To(Equal("web"))
+			Expect(*plan[0].Replicas).To(Equal(int32(1)))
+			Expect(plan[0].CpuReclaimed).To(Equal("400m"))
+
+			executed, err := r.executeEnforcement(ctx, pb, plan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(executed).To(Equal(plan))
+			scaled := &appsv1.Deployment{}
+			Expect(r.Get(ctx, types.NamespacedName{Name: "web", Namespace: ns}, scaled)).To(Succeed())
+			Expect(*scaled.Spec.Replicas).To(Equal(int32(1)))
+		})
+
+		It("should not remove more replicas than their PodDisruptionBudget allows", func() {
+			objs = append(objs, &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: ns},
+				Spec: policyv1.PodDisruptionBudgetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				},
+				Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 1},
+			})
+			r := newReconciler()
+
+			plan, err := r.planEnforcement(ctx, pb, pods, budget.Resources{CPUMilli: 300})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(HaveLen(1))
+			Expect(*plan[0].Replicas).To(Equal(int32(2)))
+			Expect(plan[0].CpuReclaimed).To(Equal("200m"))
+		})
+	})
+
+	Context("When enforcement has a grace period", func() {
+		const (
+			resourceName = "grace-budget"
+			teamName     = "grace-team"
+		)
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+		start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+		var (
+			fakeClock            *clocktesting.FakePassiveClock
+			recorder             *record.FakeRecorder
+			controllerReconciler *ProjectBudgetReconciler
+		)
+
+		reconcileAt := func(t time.Time) (reconcile.Result, *finopsv1.ProjectBudget) {
+			fakeClock.SetTime(t)
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			return result, updated
+		}
+
+		BeforeEach(func() {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: teamName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			for _, name := range []string{"pod-a", "pod-b"} {
+				Expect(k8sClient.Create(ctx, newLimitedPod(name, teamName, "200m"))).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    teamName,
+					MaxCpuLimit: "300m",
+					Enforcement: &finopsv1.EnforcementSpec{
+						Action:      finopsv1.EnforcementEvictNewest,
+						GracePeriod: &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+			})).To(Succeed())
+
+			fakeClock = clocktesting.NewFakePassiveClock(start)
+			recorder = record.NewFakeRecorder(20)
+			controllerReconciler = &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+				Clock:    fakeClock,
+			}
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(teamName))).To(Succeed())
+		})
+
+		It("should escalate notices and only evict after the deadline", func() {
+			By("detecting the overrun")
+			result, updated := reconcileAt(start)
+			Expect(result.RequeueAfter).To(Equal(5 * time.Minute))
+			Expect(updated.Status.EnforcementDeadline.Time.Equal(start.Add(10 * time.Minute))).To(BeTrue())
+			cond := meta.FindStatusCondition(updated.Status.Conditions, finopsv1.ConditionOverBudget)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal("GracePeriodStarted"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("GracePeriodStarted")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("this Pod will be affected by Evict")))
+
+			By("escalating halfway through the grace period")
+			result, updated = reconcileAt(start.Add(6 * time.Minute))
+			Expect(result.RequeueAfter).To(Equal(3 * time.Minute))
+			Expect(meta.FindStatusCondition(updated.Status.Conditions, finopsv1.ConditionOverBudget).Reason).
+				To(Equal("GracePeriodHalfway"))
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "pod-b", Namespace: teamName}, &corev1.Pod{})).To(Succeed())
+
+			By("enforcing once the deadline has passed")
+			_, updated = reconcileAt(start.Add(10 * time.Minute))
+			Expect(meta.FindStatusCondition(updated.Status.Conditions, finopsv1.ConditionOverBudget).Reason).
+				To(Equal("GracePeriodExpired"))
+			Expect(updated.Status.LastEnforcementTime).NotTo(BeNil())
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: "pod-b", Namespace: teamName}, &corev1.Pod{})
+				return errors.IsNotFound(err)
+			}).Should(BeTrue())
+		})
+
+		It("should cancel enforcement when usage comes back under the limit", func() {
+			reconcileAt(start)
+
+			Expect(k8sClient.Delete(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod-a", Namespace: teamName},
+			})).To(Succeed())
+
+			_, updated := reconcileAt(start.Add(6 * time.Minute))
+			Expect(updated.Status.OverBudgetSince).To(BeNil())
+			Expect(updated.Status.EnforcementDeadline).To(BeNil())
+			Expect(meta.IsStatusConditionFalse(updated.Status.Conditions, finopsv1.ConditionOverBudget)).To(BeTrue())
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("BackUnderBudget")))
+		})
+	})
+})
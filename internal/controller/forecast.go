@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// reconcileForecast extrapolates the consumption at the end of the billing period from the
+// recorded samples, publishes it (status and finops_projected_spend) and warns through the
+// ProjectedOverrun condition when an allowance is expected to run out before the period ends.
+func (r *ProjectBudgetReconciler) reconcileForecast(pb *finopsv1.ProjectBudget, consumed budget.Consumption, now time.Time) error {
+	spec, status := pb.Spec.Accounting, pb.Status.Consumption
+
+	samples, err := budget.ParseSamples(status)
+	if err != nil {
+		return err
+	}
+	// The current totals are always the freshest point, even between recorded samples
+	if n := len(samples); n == 0 || now.After(samples[n-1].Time) {
+		samples = append(samples, budget.Sample{Time: now, Consumption: consumed})
+	}
+
+	rate, ok := budget.ConsumptionRate(spec.ForecastModel, samples)
+	if !ok {
+		status.ProjectedCpuCoreHours, status.ProjectedMemoryGiBHours = "", ""
+		meta.SetStatusCondition(&pb.Status.Conditions, metav1.Condition{
+			Type:               finopsv1.ConditionProjectedOverrun,
+			Status:             metav1.ConditionUnknown,
+			Reason:             "InsufficientData",
+			Message:            "Not enough samples in the period to forecast consumption yet",
+			ObservedGeneration: pb.Generation,
+		})
+		return nil
+	}
+
+	periodEnd := budget.PeriodEnd(spec.Period, status.PeriodStart.Time)
+	projected := budget.Project(consumed, rate, now, periodEnd)
+	status.ProjectedCpuCoreHours = budget.FormatHours(projected.CPUCoreHours)
+	status.ProjectedMemoryGiBHours = budget.FormatHours(projected.MemoryGiBHours)
+	projectedSpend.WithLabelValues(pb.Namespace, pb.Name, "cpu_core_hours").Set(projected.CPUCoreHours)
+	projectedSpend.WithLabelValues(pb.Namespace, pb.Name, "memory_gib_hours").Set(projected.MemoryGiBHours)
+
+	allowance, err := budget.Allowance(spec)
+	if err != nil {
+		return err
+	}
+
+	condition := metav1.Condition{
+		Type:               finopsv1.ConditionProjectedOverrun,
+		Status:             metav1.ConditionFalse,
+		Reason:             "WithinAllowance",
+		Message:            fmt.Sprintf("Consumption is forecast to stay within the allowance until %s", periodEnd.UTC().Format(time.RFC3339)),
+		ObservedGeneration: pb.Generation,
+	}
+	if dimension := projected.ExhaustedBy(allowance); dimension != "" {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = dimension + "OverrunProjected"
+		condition.Message = fmt.Sprintf("%s allowance is forecast to run out before the period ends (%s model, projected %s CPU core-hours, %s Memory GiB-hours)",
+			dimension, forecastModel(spec), status.ProjectedCpuCoreHours, status.ProjectedMemoryGiBHours)
+		if at, ok := budget.ExhaustionTime(dimension, consumed, rate, allowance, now); ok {
+			condition.Message += fmt.Sprintf("; expected at %s", at.UTC().Format(time.RFC3339))
+		}
+		if !meta.IsStatusConditionTrue(pb.Status.Conditions, finopsv1.ConditionProjectedOverrun) {
+			r.Recorder.Event(pb, "Warning", "ProjectedOverrun", condition.Message)
+		}
+	}
+	meta.SetStatusCondition(&pb.Status.Conditions, condition)
+	return nil
+}
+
+// forecastModel returns the configured forecast model (Linear by default).
+func forecastModel(spec *finopsv1.AccountingSpec) finopsv1.ForecastModel {
+	if spec.ForecastModel == "" {
+		return finopsv1.ForecastLinear
+	}
+	return spec.ForecastModel
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// Reasons of the OverBudget condition. While over budget, the reason doubles as the
+// last notice sent, so each escalation step is announced exactly once.
+const (
+	reasonWithinBudget        = "WithinBudget"
+	reasonOverBudget          = "OverBudget"
+	reasonGracePeriodStarted  = "GracePeriodStarted"
+	reasonGracePeriodHalfway  = "GracePeriodHalfway"
+	reasonEnforcementImminent = "EnforcementImminent"
+	reasonGracePeriodExpired  = "GracePeriodExpired"
+)
+
+// graceNotice is one escalation step, due once a fraction of the grace period has elapsed.
+type graceNotice struct {
+	reason    string
+	at        float64
+	eventType string
+}
+
+// graceNotices escalate from an informational notice to an imminent-enforcement warning.
+var graceNotices = []graceNotice{
+	{reason: reasonGracePeriodStarted, at: 0, eventType: corev1.EventTypeNormal},
+	{reason: reasonGracePeriodHalfway, at: 0.5, eventType: corev1.EventTypeWarning},
+	{reason: reasonEnforcementImminent, at: 0.9, eventType: corev1.EventTypeWarning},
+}
+
+// gracePeriod returns the configured grace period (0 means "act immediately").
+func gracePeriod(pb *finopsv1.ProjectBudget) time.Duration {
+	if pb.Spec.Enforcement == nil || pb.Spec.Enforcement.GracePeriod == nil {
+		return 0
+	}
+	return pb.Spec.Enforcement.GracePeriod.Duration
+}
+
+// reconcileGracePeriod maintains the OverBudget condition and its deadline. It returns whether
+// the enforcement action is due now, and when the next notice or the deadline is due.
+func (r *ProjectBudgetReconciler) reconcileGracePeriod(pb *finopsv1.ProjectBudget, overBudget bool,
+	usage, ceiling budget.Resources, plan []finopsv1.PlannedAction, now time.Time) (bool, time.Duration) {
+
+	if !overBudget {
+		if pb.Status.OverBudgetSince != nil {
+			r.Recorder.Eventf(pb, corev1.EventTypeNormal, "BackUnderBudget",
+				"Namespace %s is back under budget, enforcement cancelled", pb.Spec.TeamName)
+		}
+		pb.Status.OverBudgetSince = nil
+		pb.Status.EnforcementDeadline = nil
+		r.setOverBudgetCondition(pb, metav1.ConditionFalse, reasonWithinBudget, "Usage is within the budget")
+		return false, 0
+	}
+
+	usageMsg := fmt.Sprintf("Namespace %s is over budget (CPU %dm of %dm", pb.Spec.TeamName, usage.CPUMilli, ceiling.CPUMilli)
+	if ceiling.MemoryBytes > 0 {
+		usageMsg += fmt.Sprintf(", Memory %d of %d bytes", usage.MemoryBytes, ceiling.MemoryBytes)
+	}
+	usageMsg += ")"
+
+	action := enforcementAction(pb)
+	if action == finopsv1.EnforcementNone {
+		r.setOverBudgetCondition(pb, metav1.ConditionTrue, reasonOverBudget, usageMsg+"; no enforcement configured")
+		return false, 0
+	}
+
+	if pb.Status.OverBudgetSince == nil {
+		pb.Status.OverBudgetSince = &metav1.Time{Time: now}
+		pb.Status.EnforcementDeadline = &metav1.Time{Time: now.Add(gracePeriod(pb))}
+	}
+	since := pb.Status.OverBudgetSince.Time
+	deadline := pb.Status.EnforcementDeadline.Time
+	deadlineStr := deadline.UTC().Format(time.RFC3339)
+
+	if !now.Before(deadline) {
+		msg := fmt.Sprintf("%s; grace period expired, running %s", usageMsg, action)
+		if r.setOverBudgetCondition(pb, metav1.ConditionTrue, reasonGracePeriodExpired, msg) {
+			r.Recorder.Event(pb, corev1.EventTypeWarning, "EnforcementStarted", msg)
+		}
+		return true, 0
+	}
+
+	// Find the latest escalation step that is due and the time of the next one
+	grace := deadline.Sub(since)
+	current := graceNotices[0]
+	next := deadline
+	for _, n := range graceNotices {
+		due := since.Add(time.Duration(n.at * float64(grace)))
+		if now.Before(due) {
+			next = due
+			break
+		}
+		current = n
+	}
+
+	msg := fmt.Sprintf("%s; %s will run at %s unless usage comes back under the limit", usageMsg, action, deadlineStr)
+	if r.setOverBudgetCondition(pb, metav1.ConditionTrue, current.reason, msg) {
+		r.Recorder.Event(pb, current.eventType, current.reason, msg)
+		r.notifyAffectedWorkloads(pb, plan, current, deadlineStr)
+	}
+	return false, next.Sub(now)
+}
+
+// notifyAffectedWorkloads records the notice on the objects the plan would act on, so
+// `kubectl describe` on the workload explains what is about to happen.
+func (r *ProjectBudgetReconciler) notifyAffectedWorkloads(pb *finopsv1.ProjectBudget, plan []finopsv1.PlannedAction,
+	notice graceNotice, deadline string) {
+
+	for _, step := range plan {
+		objMeta := metav1.ObjectMeta{Name: step.Name, Namespace: pb.Spec.TeamName}
+		var target client.Object
+		switch step.Kind {
+		case "Pod":
+			target = &corev1.Pod{ObjectMeta: objMeta}
+		case "Deployment":
+			target = &appsv1.Deployment{ObjectMeta: objMeta}
+		case "StatefulSet":
+			target = &appsv1.StatefulSet{ObjectMeta: objMeta}
+		default:
+			continue
+		}
+		r.Recorder.Eventf(target, notice.eventType, notice.reason,
+			"Namespace %s is over its FinOps budget %s: this %s will be affected by %s at %s unless usage comes back under the limit",
+			pb.Spec.TeamName, pb.Name, step.Kind, step.Action, deadline)
+	}
+}
+
+// setOverBudgetCondition updates the OverBudget condition and reports whether its reason changed.
+func (r *ProjectBudgetReconciler) setOverBudgetCondition(pb *finopsv1.ProjectBudget, status metav1.ConditionStatus,
+	reason, message string) bool {
+
+	previous := meta.FindStatusCondition(pb.Status.Conditions, finopsv1.ConditionOverBudget)
+	changed := previous == nil || previous.Reason != reason || previous.Status != status
+	meta.SetStatusCondition(&pb.Status.Conditions, metav1.Condition{
+		Type:               finopsv1.ConditionOverBudget,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: pb.Generation,
+	})
+	return changed
+}
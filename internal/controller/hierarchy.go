@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// reconcileAggregatedUsage propagates the usage of the descendants of a budget up to it, so an
+// organization or department budget shows what its whole subtree holds. own is the usage of
+// the budget's own namespace, already computed by the caller.
+func (r *ProjectBudgetReconciler) reconcileAggregatedUsage(ctx context.Context, pb *finopsv1.ProjectBudget, own budget.Resources) error {
+	pb.Status.AggregatedCpuUsage, pb.Status.AggregatedMemoryUsage = "", ""
+
+	var budgetList finopsv1.ProjectBudgetList
+	if err := r.List(ctx, &budgetList); err != nil {
+		return err
+	}
+	if len(budget.Children(pb, budgetList.Items)) == 0 {
+		return nil
+	}
+
+	total := own
+	for _, ns := range budget.SubtreeNamespaces(pb, budgetList.Items) {
+		if ns == pb.Spec.TeamName {
+			continue
+		}
+		var podList corev1.PodList
+		if err := r.List(ctx, &podList, client.InNamespace(ns)); err != nil {
+			return err
+		}
+		total = total.Add(budget.Usage(podList.Items))
+	}
+	pb.Status.AggregatedCpuUsage = fmt.Sprintf("%dm", total.CPUMilli)
+	pb.Status.AggregatedMemoryUsage = resource.NewQuantity(total.MemoryBytes, resource.BinarySI).String()
+	return nil
+}
+
+// withAncestors adds the ancestors of the budgets of requests, so a pod change in a team
+// namespace also refreshes its department and organization budgets.
+func withAncestors(ctx context.Context, requests []reconcile.Request, budgets []finopsv1.ProjectBudget) []reconcile.Request {
+	queued := make(map[reconcile.Request]bool, len(requests))
+	for _, req := range requests {
+		queued[req] = true
+	}
+	for i := range budgets {
+		if !queued[reconcile.Request{NamespacedName: budget.Key(&budgets[i])}] {
+			continue
+		}
+		ancestors, err := budget.Ancestors(&budgets[i], budgets)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Invalid budget hierarchy", "budget", budgets[i].Name)
+		}
+		for _, ancestor := range ancestors {
+			req := reconcile.Request{NamespacedName: budget.Key(ancestor)}
+			if !queued[req] {
+				queued[req] = true
+				requests = append(requests, req)
+			}
+		}
+	}
+	return requests
+}
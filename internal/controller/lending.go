@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// reconcileLoans settles the loans between pb and the budgets it lends to or borrows from, and
+// publishes them in status (lentTo, borrowedFrom). It returns the headroom pb borrows right now,
+// which raises its ceiling. own is the usage of the budget's namespace, already computed.
+func (r *ProjectBudgetReconciler) reconcileLoans(ctx context.Context, pb *finopsv1.ProjectBudget,
+	own budget.Resources, now time.Time) (budget.Resources, error) {
+
+	pb.Status.LentTo, pb.Status.BorrowedFrom = nil, nil
+
+	var budgetList finopsv1.ProjectBudgetList
+	if err := r.List(ctx, &budgetList); err != nil {
+		return budget.Resources{}, err
+	}
+	if len(budget.Lenders(pb, budgetList.Items)) == 0 && len(budget.Borrowers(pb, budgetList.Items)) == 0 {
+		return budget.Resources{}, nil
+	}
+
+	usage := map[string]budget.Resources{pb.Spec.TeamName: own}
+	for _, ns := range budget.LendingNamespaces(pb, budgetList.Items) {
+		if ns == pb.Spec.TeamName {
+			continue
+		}
+		var podList corev1.PodList
+		if err := r.List(ctx, &podList, client.InNamespace(ns)); err != nil {
+			return budget.Resources{}, err
+		}
+		usage[ns] = budget.Usage(podList.Items)
+	}
+
+	loans, err := budget.AllocateLoans(budgetList.Items, usage, now)
+	if err != nil {
+		return budget.Resources{}, err
+	}
+	key := budget.Key(pb)
+	for _, loan := range loans {
+		switch key {
+		case loan.Lender:
+			pb.Status.LentTo = append(pb.Status.LentTo, statusLoan(loan.Borrower, loan.Resources))
+		case loan.Borrower:
+			pb.Status.BorrowedFrom = append(pb.Status.BorrowedFrom, statusLoan(loan.Lender, loan.Resources))
+		}
+	}
+	return budget.Borrowed(loans, key), nil
+}
+
+// warnReclaimedLoans tells the borrower that lenders took headroom back and its own enforcement
+// will bring it under budget again. previous is what it borrowed at the last reconcile.
+func (r *ProjectBudgetReconciler) warnReclaimedLoans(pb *finopsv1.ProjectBudget, previous, borrowed budget.Resources) {
+	if borrowed.Covers(previous) {
+		return
+	}
+	r.Recorder.Eventf(pb, "Warning", "LoanReclaimed",
+		"Lenders reclaimed %dm CPU and %s of Memory, namespace %s is over budget (enforcement action %s)",
+		previous.CPUMilli-min(borrowed.CPUMilli, previous.CPUMilli),
+		memoryString(previous.MemoryBytes-min(borrowed.MemoryBytes, previous.MemoryBytes)),
+		pb.Spec.TeamName, enforcementAction(pb))
+}
+
+// borrowedInStatus sums the loans recorded in status by the previous reconcile.
+func borrowedInStatus(pb *finopsv1.ProjectBudget) budget.Resources {
+	var total budget.Resources
+	for _, loan := range pb.Status.BorrowedFrom {
+		if q, err := resource.ParseQuantity(loan.Cpu); err == nil {
+			total.CPUMilli += q.MilliValue()
+		}
+		if q, err := resource.ParseQuantity(loan.Memory); err == nil {
+			total.MemoryBytes += q.Value()
+		}
+	}
+	return total
+}
+
+// statusLoan renders a loan with the other party of the budget reporting it.
+func statusLoan(other types.NamespacedName, lent budget.Resources) finopsv1.Loan {
+	loan := finopsv1.Loan{
+		Budget: finopsv1.BudgetReference{Name: other.Name, Namespace: other.Namespace},
+		Cpu:    fmt.Sprintf("%dm", lent.CPUMilli),
+	}
+	if lent.MemoryBytes > 0 {
+		loan.Memory = memoryString(lent.MemoryBytes)
+	}
+	return loan
+}
+
+// withLoanParties adds the lenders and borrowers of the budgets of requests: the loans of a
+// budget move whenever the usage of the other party does.
+func withLoanParties(requests []reconcile.Request, budgets []finopsv1.ProjectBudget) []reconcile.Request {
+	queued := make(map[reconcile.Request]bool, len(requests))
+	for _, req := range requests {
+		queued[req] = true
+	}
+	for i := range budgets {
+		if !queued[reconcile.Request{NamespacedName: budget.Key(&budgets[i])}] {
+			continue
+		}
+		parties := append(budget.Lenders(&budgets[i], budgets), budget.Borrowers(&budgets[i], budgets)...)
+		for _, party := range parties {
+			req := reconcile.Request{NamespacedName: budget.Key(party)}
+			if !queued[req] {
+				queued[req] = true
+				requests = append(requests, req)
+			}
+		}
+	}
+	return requests
+}
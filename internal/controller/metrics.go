@@ -0,0 +1,108 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+var (
+	// budgetLimit is the limit in force (after schedules and loans, before any burst)
+	budgetLimit = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "finops_budget_limit",
+			Help: "Limit in force for the namespace of the budget (cpu in cores, memory in bytes)",
+		},
+		[]string{"namespace", "budget", "resource"},
+	)
+
+	// budgetUsed is the sum of the limits of the active pods of the namespace
+	budgetUsed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "finops_budget_used",
+			Help: "Limits reserved by the active pods of the namespace of the budget (cpu in cores, memory in bytes)",
+		},
+		[]string{"namespace", "budget", "resource"},
+	)
+
+	// budgetUtilization is used divided by limit; the headroom left is 1 minus the ratio
+	budgetUtilization = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "finops_budget_utilization_ratio",
+			Help: "Reserved limits divided by the limit in force of the budget (cpu, memory)",
+		},
+		[]string{"namespace", "budget", "resource"},
+	)
+
+	// projectedSpend is the consumption forecast for the end of the billing period
+	projectedSpend = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "finops_projected_spend",
+			Help: "Consumption forecast for the end of the billing period (cpu_core_hours, memory_gib_hours)",
+		},
+		[]string{"namespace", "budget", "resource"},
+	)
+
+	// efficiencyRatio compares what the pods use (metrics.k8s.io) with what they reserve (limits)
+	efficiencyRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "finops_budget_efficiency_ratio",
+			Help: "Observed usage divided by reserved limits of the namespace (cpu, memory)",
+		},
+		[]string{"namespace", "budget", "resource"},
+	)
+)
+
+func init() {
+	// Register the metrics in the global registry of controller-runtime
+	metrics.Registry.MustRegister(budgetLimit, budgetUsed, budgetUtilization, projectedSpend, efficiencyRatio)
+}
+
+// forgetBudgetMetrics drops every series of a budget (e.g., once it is deleted).
+func forgetBudgetMetrics(namespace, name string) {
+	labels := prometheus.Labels{"namespace": namespace, "budget": name}
+	budgetLimit.DeletePartialMatch(labels)
+	budgetUsed.DeletePartialMatch(labels)
+	budgetUtilization.DeletePartialMatch(labels)
+	projectedSpend.DeletePartialMatch(labels)
+	efficiencyRatio.DeletePartialMatch(labels)
+}
+
+// recordBudgetMetrics exports the usage and limits of a budget. Budgets without a memory cap
+// only export the memory used.
+func recordBudgetMetrics(namespace, name string, used, limits budget.Resources) {
+	budgetUsed.WithLabelValues(namespace, name, "cpu").Set(float64(used.CPUMilli) / 1000)
+	budgetUsed.WithLabelValues(namespace, name, "memory").Set(float64(used.MemoryBytes))
+
+	budgetLimit.WithLabelValues(namespace, name, "cpu").Set(float64(limits.CPUMilli) / 1000)
+	if limits.CPUMilli > 0 {
+		budgetUtilization.WithLabelValues(namespace, name, "cpu").Set(float64(used.CPUMilli) / float64(limits.CPUMilli))
+	} else {
+		budgetUtilization.DeleteLabelValues(namespace, name, "cpu")
+	}
+
+	if limits.MemoryBytes == 0 {
+		budgetLimit.DeleteLabelValues(namespace, name, "memory")
+		budgetUtilization.DeleteLabelValues(namespace, name, "memory")
+		return
+	}
+	budgetLimit.WithLabelValues(namespace, name, "memory").Set(float64(limits.MemoryBytes))
+	budgetUtilization.WithLabelValues(namespace, name, "memory").Set(float64(used.MemoryBytes) / float64(limits.MemoryBytes))
+}
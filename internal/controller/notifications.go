@@ -0,0 +1,282 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/notify"
+)
+
+// Notifier delivers a notification of a budget to one of its channels (e.g., a notify.Dispatcher).
+type Notifier interface {
+	Notify(ctx context.Context, channel *finopsv1.NotificationChannel, msg notify.Message) error
+}
+
+// defaultNotificationThresholds and defaultDenialDebounce apply when the CRD defaults are missing.
+var defaultNotificationThresholds = []int32{80, 100}
+
+const defaultDenialDebounce = 15 * time.Minute
+
+// maxDeniedPodsListed caps the pods detailed in a PodDenied notification.
+const maxDeniedPodsListed = 10
+
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=notificationchannels,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
+
+// reconcileNotifications updates the notification state of the budget and returns the threshold
+// crossings and denials to notify, and when the debounced denials are due (0 if none is pending).
+func (r *ProjectBudgetReconciler) reconcileNotifications(ctx context.Context, pb *finopsv1.ProjectBudget,
+	usage, limits budget.Resources, now time.Time) ([]notify.Message, time.Duration) {
+
+	if pb.Spec.Notifications == nil {
+		pb.Status.NotifiedThreshold = 0
+		pb.Status.LastDenialNotificationTime = nil
+		return nil, 0
+	}
+
+	var messages []notify.Message
+	if msg := thresholdNotification(pb, usage, limits, now); msg != nil {
+		messages = append(messages, *msg)
+	}
+	msg, requeueAfter, err := r.denialNotification(ctx, pb, now)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list BudgetViolations to notify", "namespace", pb.Spec.TeamName)
+	}
+	if msg != nil {
+		messages = append(messages, *msg)
+	}
+	return messages, requeueAfter
+}
+
+// thresholdNotification moves status.notifiedThreshold to the highest threshold reached and returns
+// a message when it went up. Dropping below a threshold re-arms it silently.
+func thresholdNotification(pb *finopsv1.ProjectBudget, usage, limits budget.Resources, now time.Time) *notify.Message {
+	percent, resourceName := utilizationPercent(usage, limits)
+	thresholds := pb.Spec.Notifications.Thresholds
+	if len(thresholds) == 0 {
+		thresholds = defaultNotificationThresholds
+	}
+	var reached int32
+	for _, t := range thresholds {
+		if int64(t) <= percent && t > reached {
+			reached = t
+		}
+	}
+
+	previous := pb.Status.NotifiedThreshold
+	pb.Status.NotifiedThreshold = reached
+	if reached <= previous {
+		return nil
+	}
+
+	details := []string{fmt.Sprintf("CPU: %s of %s",
+		resource.NewMilliQuantity(usage.CPUMilli, resource.DecimalSI), resource.NewMilliQuantity(limits.CPUMilli, resource.DecimalSI))}
+	if limits.MemoryBytes > 0 {
+		details = append(details, fmt.Sprintf("Memory: %s of %s",
+			resource.NewQuantity(usage.MemoryBytes, resource.BinarySI), resource.NewQuantity(limits.MemoryBytes, resource.BinarySI)))
+	}
+	msg := newNotification(pb, finopsv1.NotifyThresholdCrossed, now,
+		fmt.Sprintf("Namespace %s reached %d%% of its %s budget (threshold %d%%)", pb.Spec.TeamName, percent, resourceName, reached))
+	msg.Details = details
+	return &msg
+}
+
+// utilizationPercent returns the highest utilization of the CPU and Memory limits, in percent,
+// and the resource it belongs to. Memory only counts when the budget caps it.
+func utilizationPercent(usage, limits budget.Resources) (int64, string) {
+	var percent int64
+	resourceName := "CPU"
+	if limits.CPUMilli > 0 {
+		percent = usage.CPUMilli * 100 / limits.CPUMilli
+	}
+	if limits.MemoryBytes > 0 {
+		if memory := usage.MemoryBytes * 100 / limits.MemoryBytes; memory > percent {
+			percent, resourceName = memory, "Memory"
+		}
+	}
+	return percent, resourceName
+}
+
+// denialNotification summarizes the pods denied (or admitted in DryRun mode) by the webhook since
+// the last notification, at most once per debounce interval. It returns when the pending denials
+// are due when the interval hasn't elapsed yet.
+func (r *ProjectBudgetReconciler) denialNotification(ctx context.Context, pb *finopsv1.ProjectBudget,
+	now time.Time) (*notify.Message, time.Duration, error) {
+
+	debounce := defaultDenialDebounce
+	if d := pb.Spec.Notifications.DenialDebounce.Duration; d > 0 {
+		debounce = d
+	}
+	// The first notification covers the last debounce interval, not the whole history
+	since := now.Add(-debounce)
+	last := pb.Status.LastDenialNotificationTime
+	if last != nil {
+		since = last.Time
+	}
+
+	var violations finopsv1.BudgetViolationList
+	if err := r.List(ctx, &violations, client.InNamespace(pb.Spec.TeamName),
+		client.MatchingLabels{finopsv1.BudgetNameLabel: pb.Name}); err != nil {
+		return nil, 0, err
+	}
+	var denials []finopsv1.BudgetViolation
+	for _, v := range violations.Items {
+		if v.Spec.Source == finopsv1.ViolationSourceAdmission && v.Spec.BudgetKind == "ProjectBudget" &&
+			v.CreationTimestamp.After(since) {
+			denials = append(denials, v)
+		}
+	}
+	if len(denials) == 0 {
+		return nil, 0, nil
+	}
+	if last != nil && now.Before(last.Add(debounce)) {
+		return nil, last.Add(debounce).Sub(now), nil
+	}
+	pb.Status.LastDenialNotificationTime = &metav1.Time{Time: now}
+
+	sort.Slice(denials, func(i, j int) bool {
+		return denials[i].CreationTimestamp.Before(&denials[j].CreationTimestamp)
+	})
+	var denied, dryRun int
+	var details []string
+	for _, v := range denials {
+		if v.Spec.Action == finopsv1.ViolationDryRun {
+			dryRun++
+		} else {
+			denied++
+		}
+		if len(details) < maxDeniedPodsListed {
+			details = append(details, fmt.Sprintf("%s pod %s: %s", v.Spec.Action, v.Spec.Pod, v.Spec.Message))
+		}
+	}
+	if more := len(denials) - len(details); more > 0 {
+		details = append(details, fmt.Sprintf("... and %d more (kubectl get budgetviolations -n %s)", more, pb.Spec.TeamName))
+	}
+
+	summary := fmt.Sprintf("%d pods denied in namespace %s since %s", denied, pb.Spec.TeamName, since.UTC().Format(time.RFC3339))
+	if dryRun > 0 {
+		summary = fmt.Sprintf("%d pods denied and %d admitted in DryRun mode in namespace %s since %s",
+			denied, dryRun, pb.Spec.TeamName, since.UTC().Format(time.RFC3339))
+	}
+	msg := newNotification(pb, finopsv1.NotifyPodDenied, now, summary)
+	msg.Details = details
+	return &msg, 0, nil
+}
+
+// enforcementNotification describes the enforcement steps executed on the namespace.
+func enforcementNotification(pb *finopsv1.ProjectBudget, executed []finopsv1.PlannedAction, now time.Time) notify.Message {
+	msg := newNotification(pb, finopsv1.NotifyEnforcement, now,
+		fmt.Sprintf("%s ran in namespace %s to bring it back under budget", enforcementAction(pb), pb.Spec.TeamName))
+	for _, step := range executed {
+		target := fmt.Sprintf("%s %s", step.Kind, step.Name)
+		if step.Replicas != nil {
+			target += fmt.Sprintf(" to %d replicas", *step.Replicas)
+		}
+		msg.Details = append(msg.Details, fmt.Sprintf("%s %s (reclaimed %s CPU, %s memory)",
+			step.Action, target, step.CpuReclaimed, step.MemoryReclaimed))
+	}
+	return msg
+}
+
+// newNotification starts a message about a budget.
+func newNotification(pb *finopsv1.ProjectBudget, event finopsv1.NotificationEvent, now time.Time, summary string) notify.Message {
+	return notify.Message{
+		Event:     event,
+		Time:      now,
+		Budget:    pb.Name,
+		Namespace: pb.Namespace,
+		Team:      pb.Spec.TeamName,
+		Summary:   summary,
+	}
+}
+
+// deliverNotifications hands the messages to every channel of the budget subscribed to them.
+// Failures are reported as NotificationFailed events on the budget.
+func (r *ProjectBudgetReconciler) deliverNotifications(ctx context.Context, pb *finopsv1.ProjectBudget, messages []notify.Message) {
+	if r.Notifier == nil || pb.Spec.Notifications == nil || len(messages) == 0 {
+		return
+	}
+	logger := log.FromContext(ctx)
+
+	for _, name := range pb.Spec.Notifications.ChannelRefs {
+		var channel finopsv1.NotificationChannel
+		if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: pb.Namespace}, &channel); err != nil {
+			logger.Error(err, "Failed to get NotificationChannel", "channel", name)
+			r.Recorder.Eventf(pb, corev1.EventTypeWarning, "NotificationFailed", "Cannot load NotificationChannel %s: %v", name, err)
+			continue
+		}
+		for _, msg := range messages {
+			if !subscribed(&channel, msg.Event) {
+				continue
+			}
+			if err := r.Notifier.Notify(ctx, &channel, msg); err != nil {
+				logger.Error(err, "Failed to queue notification", "channel", name, "event", msg.Event)
+				r.Recorder.Eventf(pb, corev1.EventTypeWarning, "NotificationFailed",
+					"Cannot notify %s to channel %s: %v", msg.Event, name, err)
+			}
+		}
+	}
+}
+
+// subscribed tells whether a channel takes an event (channels without events take them all).
+func subscribed(channel *finopsv1.NotificationChannel, event finopsv1.NotificationEvent) bool {
+	if len(channel.Spec.Events) == 0 {
+		return true
+	}
+	for _, e := range channel.Spec.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// budgetsForViolation maps a BudgetViolation recorded by the webhook to the budget notifying its denials.
+func (r *ProjectBudgetReconciler) budgetsForViolation(ctx context.Context, obj client.Object) []reconcile.Request {
+	violation, ok := obj.(*finopsv1.BudgetViolation)
+	if !ok || violation.Spec.Source != finopsv1.ViolationSourceAdmission {
+		return nil
+	}
+	var budgetList finopsv1.ProjectBudgetList
+	if err := r.List(ctx, &budgetList); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list budgets for violation event")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, b := range budgetList.Items {
+		if b.Name == violation.Labels[finopsv1.BudgetNameLabel] && b.Spec.TeamName == violation.Namespace &&
+			b.Spec.Notifications != nil {
+			requests = append(requests, reconcile.Request{NamespacedName: budget.Key(&b)})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/notify"
+)
+
+// fakeNotifier keeps the notifications handed to each channel.
+type fakeNotifier struct {
+	mu   sync.Mutex
+	sent map[string][]notify.Message
+}
+
+func (f *fakeNotifier) Notify(_ context.Context, channel *finopsv1.NotificationChannel, msg notify.Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.sent == nil {
+		f.sent = map[string][]notify.Message{}
+	}
+	f.sent[channel.Name] = append(f.sent[channel.Name], msg)
+	return nil
+}
+
+var _ = Describe("Budget notifications", func() {
+	ctx := context.Background()
+	start := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	var pb *finopsv1.ProjectBudget
+
+	BeforeEach(func() {
+		pb = &finopsv1.ProjectBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-n-budget", Namespace: "default"},
+			Spec: finopsv1.ProjectBudgetSpec{
+				TeamName: "team-n",
+				Notifications: &finopsv1.NotificationSpec{
+					ChannelRefs:    []string{"ops"},
+					Thresholds:     []int32{80, 100},
+					DenialDebounce: metav1.Duration{Duration: 15 * time.Minute},
+				},
+			},
+		}
+	})
+
+	newFakeClient := func(objs ...client.Object) client.Client {
+		s := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(s)).To(Succeed())
+		Expect(finopsv1.AddToScheme(s)).To(Succeed())
+		return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+	}
+
+	denial := func(name string, action finopsv1.ViolationAction, at time.Time) *finopsv1.BudgetViolation {
+		return &finopsv1.BudgetViolation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "team-n",
+				Labels:            map[string]string{finopsv1.BudgetNameLabel: "team-n-budget"},
+				CreationTimestamp: metav1.NewTime(at),
+			},
+			Spec: finopsv1.BudgetViolationSpec{
+				BudgetKind: "ProjectBudget",
+				BudgetName: "team-n-budget",
+				Source:     finopsv1.ViolationSourceAdmission,
+				Action:     action,
+				Message:    "CPU Budget exceeded",
+				Pod:        name,
+				ExpiresAt:  metav1.NewTime(at.Add(24 * time.Hour)),
+			},
+		}
+	}
+
+	It("should announce each threshold once until usage drops below it", func() {
+		r := &ProjectBudgetReconciler{Client: newFakeClient()}
+		limits := budget.Resources{CPUMilli: 2000, MemoryBytes: 4 << 30}
+
+		messages, _ := r.reconcileNotifications(ctx, pb, budget.Resources{CPUMilli: 1700, MemoryBytes: 1 << 30}, limits, start)
+		Expect(messages).To(HaveLen(1))
+		Expect(messages[0].Event).To(Equal(finopsv1.NotifyThresholdCrossed))
+		Expect(messages[0].Summary).To(Equal("Namespace team-n reached 85% of its CPU budget (threshold 80%)"))
+		Expect(messages[0].Details).To(Equal([]string{"CPU: 1700m of 2", "Memory: 1Gi of 4Gi"}))
+		Expect(pb.Status.NotifiedThreshold).To(Equal(int32(80)))
+
+		messages, _ = r.reconcileNotifications(ctx, pb, budget.Resources{CPUMilli: 1800}, limits, start)
+		Expect(messages).To(BeEmpty())
+
+		// Memory counts too, and the highest threshold reached wins
+		messages, _ = r.reconcileNotifications(ctx, pb, budget.Resources{CPUMilli: 1800, MemoryBytes: 5 << 30}, limits, start)
+		Expect(messages).To(HaveLen(1))
+		Expect(messages[0].Summary).To(Equal("Namespace team-n reached 125% of its Memory budget (threshold 100%)"))
+
+		// Back under 80%: the thresholds are re-armed without a message
+		messages, _ = r.reconcileNotifications(ctx, pb, budget.Resources{CPUMilli: 1000}, limits, start)
+		Expect(messages).To(BeEmpty())
+		Expect(pb.Status.NotifiedThreshold).To(BeZero())
+		messages, _ = r.reconcileNotifications(ctx, pb, budget.Resources{CPUMilli: 1600}, limits, start)
+		Expect(messages).To(HaveLen(1))
+	})
+
+	It("should debounce denials into one summary per interval", func() {
+		c := newFakeClient(
+			denial("api-1", finopsv1.ViolationDenied, start.Add(-time.Minute)),
+			denial("api-2", finopsv1.ViolationDryRun, start.Add(-30*time.Second)),
+			denial("old", finopsv1.ViolationDenied, start.Add(-time.Hour)),
+		)
+		r := &ProjectBudgetReconciler{Client: c}
+		usage := budget.Resources{CPUMilli: 100}
+		limits := budget.Resources{CPUMilli: 2000}
+
+		messages, requeue := r.reconcileNotifications(ctx, pb, usage, limits, start)
+		Expect(requeue).To(BeZero())
+		Expect(messages).To(HaveLen(1))
+		Expect(messages[0].Event).To(Equal(finopsv1.NotifyPodDenied))
+		Expect(messages[0].Summary).To(Equal("1 pods denied and 1 admitted in DryRun mode in namespace team-n since 2026-03-04T09:45:00Z"))
+		Expect(messages[0].Details).To(Equal([]string{
+			"Denied pod api-1: CPU Budget exceeded",
+			"DryRun pod api-2: CPU Budget exceeded",
+		}))
+		Expect(pb.Status.LastDenialNotificationTime.Time).To(Equal(start))
+
+		// Another denial within the interval waits for it to elapse
+		Expect(c.Create(ctx, denial("api-3", finopsv1.ViolationDenied, start.Add(5*time.Minute)))).To(Succeed())
+		messages, requeue = r.reconcileNotifications(ctx, pb, usage, limits, start.Add(5*time.Minute))
+		Expect(messages).To(BeEmpty())
+		Expect(requeue).To(Equal(10 * time.Minute))
+
+		messages, _ = r.reconcileNotifications(ctx, pb, usage, limits, start.Add(15*time.Minute))
+		Expect(messages).To(HaveLen(1))
+		Expect(messages[0].Summary).To(Equal("1 pods denied in namespace team-n since 2026-03-04T10:00:00Z"))
+		Expect(messages[0].Details).To(Equal([]string{"Denied pod api-3: CPU Budget exceeded"}))
+	})
+
+	It("should deliver to the channels subscribed to each event", func() {
+		pb.Spec.Notifications.ChannelRefs = []string{"ops", "finance", "missing"}
+		c := newFakeClient(
+			&finopsv1.NotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "ops", Namespace: "default"},
+				Spec:       finopsv1.NotificationChannelSpec{Type: finopsv1.ChannelSlack},
+			},
+			&finopsv1.NotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "finance", Namespace: "default"},
+				Spec: finopsv1.NotificationChannelSpec{
+					Type:   finopsv1.ChannelSMTP,
+					Events: []finopsv1.NotificationEvent{finopsv1.NotifyEnforcement},
+				},
+			},
+		)
+		notifier := &fakeNotifier{}
+		recorder := record.NewFakeRecorder(10)
+		r := &ProjectBudgetReconciler{Client: c, Notifier: notifier, Recorder: recorder}
+
+		replicas := int32(1)
+		pb.Spec.Enforcement = &finopsv1.EnforcementSpec{Action: finopsv1.EnforcementScaleDownWorkloads}
+		enforcement := enforcementNotification(pb, []finopsv1.PlannedAction{{
+			Action: finopsv1.PlannedScaleDown, Kind: "Deployment", Name: "web", Replicas: &replicas,
+			CpuReclaimed: "400m", MemoryReclaimed: "0",
+		}}, start)
+		threshold := newNotification(pb, finopsv1.NotifyThresholdCrossed, start, "Namespace team-n reached 80% of its CPU budget")
+		r.deliverNotifications(ctx, pb, []notify.Message{threshold, enforcement})
+
+		Expect(notifier.sent["ops"]).To(Equal([]notify.Message{threshold, enforcement}))
+		Expect(notifier.sent["finance"]).To(Equal([]notify.Message{enforcement}))
+		Expect(enforcement.Summary).To(Equal("ScaleDownWorkloads ran in namespace team-n to bring it back under budget"))
+		Expect(enforcement.Details).To(Equal([]string{"ScaleDown Deployment web to 1 replicas (reclaimed 400m CPU, 0 memory)"}))
+
+		var event string
+		Expect(recorder.Events).To(Receive(&event))
+		Expect(event).To(HavePrefix("Warning NotificationFailed Cannot load NotificationChannel missing"))
+	})
+})
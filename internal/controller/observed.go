@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list
+
+// reconcileObservedUsage records what the pods actually use (metrics.k8s.io) next to what they
+// reserve, and exports the efficiency ratio. It returns the usage of each active pod by name,
+// or nil when no MetricsClient is configured or metrics are unavailable.
+func (r *ProjectBudgetReconciler) reconcileObservedUsage(ctx context.Context, pb *finopsv1.ProjectBudget,
+	pods []corev1.Pod, reserved budget.Resources) map[string]budget.Resources {
+
+	pb.Status.ObservedCpuUsage, pb.Status.ObservedMemoryUsage = "", ""
+	if r.MetricsClient == nil {
+		return nil
+	}
+
+	perPod, err := r.observedUsage(ctx, pb.Spec.TeamName, pods)
+	if err != nil {
+		// metrics-server may be missing or still warming up: budgets keep working on limits
+		log.FromContext(ctx).Error(err, "Failed to read pod metrics", "namespace", pb.Spec.TeamName)
+		efficiencyRatio.DeletePartialMatch(prometheus.Labels{"namespace": pb.Namespace, "budget": pb.Name})
+		return nil
+	}
+
+	var observed budget.Resources
+	for _, usage := range perPod {
+		observed = observed.Add(usage)
+	}
+	pb.Status.ObservedCpuUsage = fmt.Sprintf("%dm", observed.CPUMilli)
+	pb.Status.ObservedMemoryUsage = resource.NewQuantity(observed.MemoryBytes, resource.BinarySI).String()
+
+	if reserved.CPUMilli > 0 {
+		efficiencyRatio.WithLabelValues(pb.Namespace, pb.Name, "cpu").
+			Set(float64(observed.CPUMilli) / float64(reserved.CPUMilli))
+	}
+	if reserved.MemoryBytes > 0 {
+		efficiencyRatio.WithLabelValues(pb.Namespace, pb.Name, "memory").
+			Set(float64(observed.MemoryBytes) / float64(reserved.MemoryBytes))
+	}
+	return perPod
+}
+
+// observedUsage returns the usage reported by metrics.k8s.io for the active pods of the list,
+// so observed and reserved amounts always cover the same pods.
+func (r *ProjectBudgetReconciler) observedUsage(ctx context.Context, namespace string,
+	pods []corev1.Pod) (map[string]budget.Resources, error) {
+
+	podMetrics, err := r.MetricsClient.MetricsV1beta1().PodMetricses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	active := make(map[string]bool, len(pods))
+	for i := range pods {
+		if budget.IsActive(&pods[i]) {
+			active[pods[i].Name] = true
+		}
+	}
+
+	perPod := make(map[string]budget.Resources, len(podMetrics.Items))
+	for _, pm := range podMetrics.Items {
+		if !active[pm.Name] {
+			continue
+		}
+		var usage budget.Resources
+		for _, c := range pm.Containers {
+			usage.CPUMilli += c.Usage.Cpu().MilliValue()
+			usage.MemoryBytes += c.Usage.Memory().Value()
+		}
+		perPod[pm.Name] = usage
+	}
+	return perPod, nil
+}
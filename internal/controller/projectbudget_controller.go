@@ -19,28 +19,65 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/cost"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/notify"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/tracing"
 )
 
 // ProjectBudgetReconciler reconciles a ProjectBudget object
 type ProjectBudgetReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// Clock is used for every time-based decision (bursts, schedules). Defaults to the real clock.
+	Clock clock.PassiveClock
+	// MetricsClient reads actual pod usage from metrics.k8s.io. Optional: nil only tracks limits.
+	MetricsClient metricsclientset.Interface
+	// TracerProvider creates the reconciliation spans. Defaults to the global provider (no-op unless configured).
+	TracerProvider trace.TracerProvider
+	// ViolationTTL is how long the BudgetViolation recorded when a namespace goes over budget is kept. None is created when 0.
+	ViolationTTL time.Duration
+	// Notifier delivers the notifications of budgets with spec.notifications. Optional: nil sends none.
+	Notifier Notifier
+
+	// history keeps the observed usage behind right-sizing recommendations
+	history usageHistory
 }
 
-// +kubebuilder:rbac:groups="",resources=projectbudgets,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=projectbudgets/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups="",resources=projectbudgets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=projectbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=projectbudgets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=projectbudgets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=costmodels,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=budgetviolations,verbs=create;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -52,76 +89,397 @@ type ProjectBudgetReconciler struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.22.4/pkg/reconcile
 func (r *ProjectBudgetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracing.Tracer(r.TracerProvider).Start(ctx, "ProjectBudgetReconciler.Reconcile",
+		trace.WithAttributes(tracing.NamespaceKey.String(req.Namespace), tracing.BudgetKey.String(req.Name)))
+	defer span.End()
+
+	result, err := r.reconcile(ctx, req)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+// reconcile brings the status (and, when opted in, the namespace) in line with the budget.
+func (r *ProjectBudgetReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
 	// 1. Get the ProjectBudget instance that triggered this event
 	var projectBudget finopsv1.ProjectBudget
 	if err := r.Get(ctx, req.NamespacedName, &projectBudget); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The budget is gone: stop exporting its metrics
+			forgetBudgetMetrics(req.Namespace, req.Name)
+			r.history.forget(req.NamespacedName)
+		}
 		// If not found, return. Created objects are automatically garbage collected.
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	// The budget is being deleted: clean up what garbage collection can't
+	if !projectBudget.DeletionTimestamp.IsZero() {
+		// Finalizers may hold it for a while: stop exporting its metrics right away
+		forgetBudgetMetrics(req.Namespace, req.Name)
+		r.history.forget(req.NamespacedName)
+		return ctrl.Result{}, r.finalizeQuota(ctx, &projectBudget)
+	}
+	if err := r.reconcileQuotaFinalizer(ctx, &projectBudget); err != nil {
+		logger.Error(err, "Failed to update finalizers")
+		return ctrl.Result{}, err
+	}
+
 	// 2. List all Pods in the budget namespace
-	var podList corev1.PodList
 	// Using the namespace defined in the spec (e.g., "team-beta")
 	targetNamespace := projectBudget.Spec.TeamName
 
-	if err := r.List(ctx, &podList, client.InNamespace(targetNamespace)); err != nil {
+	// 3. Calculate current usage (limits of all running/pending pods)
+	podList, usage, err := r.namespaceUsage(ctx, targetNamespace)
+	if err != nil {
 		logger.Error(err, "Failed to list pods in namespace", "namespace", targetNamespace)
 		return ctrl.Result{}, err
 	}
+	totalCpuUsage := usage.CPUMilli
 
-	// 3. Calculate current CPU usage
-	var totalCpuUsage int64 = 0
-	for _, pod := range podList.Items {
-		// Sum the limits of all containers in the pod
-		for _, container := range pod.Spec.Containers {
-			cpuLimit := container.Resources.Limits.Cpu()
-			if cpuLimit != nil {
-				// MilliValue returns CPU in millicores (1 Core = 1000m)
-				totalCpuUsage += cpuLimit.MilliValue()
-			}
-		}
-	}
-
-	// 4. Compare with the defined limit
-	// Parse the limit from the CRD (e.g., "1500m")
-	maxCpuLimitQuantity, err := resource.ParseQuantity(projectBudget.Spec.MaxCpuLimit)
+	// 4. Compare with the limits in force right now
+	// (a scheduled window, e.g. "nights", may replace MaxCpuLimit/MaxMemoryLimit)
+	now := r.now()
+	limits, window, err := budget.EffectiveLimits(&projectBudget.Spec, now)
 	if err != nil {
-		logger.Error(err, "Invalid MaxCpuLimit format in CRD")
+		logger.Error(err, "Invalid limits or schedules in CRD")
 		return ctrl.Result{}, nil // Does not retry if the format is invalid
+	}
+	if window != projectBudget.Status.ActiveWindow {
+		r.Recorder.Eventf(&projectBudget, "Normal", "BudgetWindowChanged", "Active budget window changed from %s to %s (CPU limit %dm)",
+			windowName(projectBudget.Status.ActiveWindow), windowName(window), limits.CPUMilli)
+	}
+	projectBudget.Status.ActiveWindow = window
 
+	// Wake up when the next window opens or closes
+	var requeueAfter time.Duration
+	if boundary, _ := budget.NextWindowBoundary(&projectBudget.Spec, now); !boundary.IsZero() {
+		requeueAfter = boundary.Sub(now)
 	}
-	maxCpuMilli := maxCpuLimitQuantity.MilliValue()
+
+	// Headroom borrowed from other teams counts as budget until the lenders need it back
+	previousBorrowed := borrowedInStatus(&projectBudget)
+	borrowed, err := r.reconcileLoans(ctx, &projectBudget, usage, now)
+	if err != nil {
+		logger.Error(err, "Failed to settle loans with other budgets")
+	}
+	limits = limits.Add(borrowed)
+	recordBudgetMetrics(projectBudget.Namespace, projectBudget.Name, usage, limits)
 
 	// 5. Decision Logic (Governance)
+	if projectBudget.Spec.Burst != nil {
+		requeueAfter = earliestRequeue(requeueAfter, r.reconcileBurst(&projectBudget, usage.Exceeds(limits), now))
+	}
 
-	if totalCpuUsage > maxCpuMilli {
-		logger.Info("VIOLATION DETECTED", "Namespace", targetNamespace, "Current", totalCpuUsage, "Limit", maxCpuMilli)
+	// Usage covered by an ongoing burst is tolerated until the window expires
+	burst, err := budget.BurstAllowance(&projectBudget, now)
+	if err != nil {
+		logger.Error(err, "Invalid burst format in CRD")
+	}
+	ceiling := limits.Add(burst)
 
-		// HERE is where in the future we would delete pods or block deployments.
-		// But for now, we just log.
+	var plan []finopsv1.PlannedAction
+	overBudget := usage.Exceeds(ceiling)
+	if overBudget {
+		logger.Info("VIOLATION DETECTED", "Namespace", targetNamespace, "Current", totalCpuUsage, "Limit", ceiling.CPUMilli)
+		r.warnReclaimedLoans(&projectBudget, previousBorrowed, borrowed)
 
+		// Opt-in: compute how to bring the namespace back under budget
+		if enforcementAction(&projectBudget) != finopsv1.EnforcementNone {
+			plan, err = r.planEnforcement(ctx, &projectBudget, podList.Items, usage.Excess(ceiling))
+			if err != nil {
+				logger.Error(err, "Failed to plan enforcement", "namespace", targetNamespace)
+				return ctrl.Result{}, err
+			}
+		}
 	} else {
 		logger.Info("Budget OK", "Namespace", targetNamespace, "Usage", totalCpuUsage)
 	}
 
+	// Give the team a grace period (with escalating notices) before acting
+	wasOverBudget := meta.IsStatusConditionTrue(projectBudget.Status.Conditions, finopsv1.ConditionOverBudget)
+	enforcementDue, graceRequeue := r.reconcileGracePeriod(&projectBudget, overBudget, usage, ceiling, plan, now)
+	requeueAfter = earliestRequeue(requeueAfter, graceRequeue)
+
+	// The plan is always published first, so DryRun budgets show what WOULD have happened
+	enforce := enforcementDue && len(plan) > 0 && projectBudget.Spec.ValidationMode != finopsv1.DryRunMode
+	projectBudget.Status.EnforcementPlan = plan
+	if enforce {
+		projectBudget.Status.LastEnforcementTime = &metav1.Time{Time: now}
+	}
+
+	// Integrate usage over the billing period (core-hours, GiB-hours)
+	consumptionRequeue, err := r.reconcileConsumption(&projectBudget, usage, now)
+	if err != nil {
+		logger.Error(err, "Invalid accounting allowances or consumption in CRD")
+	}
+	requeueAfter = earliestRequeue(requeueAfter, consumptionRequeue)
+
+	// Threshold crossings and (debounced) denials, sent once the new state is persisted
+	notifications, notificationRequeue := r.reconcileNotifications(ctx, &projectBudget, usage, limits, now)
+	requeueAfter = earliestRequeue(requeueAfter, notificationRequeue)
+
 	// 6. Update the ProjectBudget status (visual feedback for the user)
 	projectBudget.Status.CurrentCpuUsage = fmt.Sprintf("%dm", totalCpuUsage)
-	projectBudget.Status.LastCheckTime = "Just Now"
+	projectBudget.Status.CurrentMemoryUsage = resource.NewQuantity(usage.MemoryBytes, resource.BinarySI).String()
+	if err := r.reconcileAggregatedUsage(ctx, &projectBudget, usage); err != nil {
+		logger.Error(err, "Failed to aggregate usage of child budgets")
+		return ctrl.Result{}, err
+	}
+	perPodUsage := r.reconcileObservedUsage(ctx, &projectBudget, podList.Items, usage)
+	if r.MetricsClient != nil {
+		// Observed usage changes without any pod event: sample it again
+		requeueAfter = earliestRequeue(requeueAfter, recommendationSampleInterval)
+	}
+	if err := r.reconcileRecommendations(ctx, &projectBudget, podList.Items, perPodUsage, now); err != nil {
+		logger.Error(err, "Failed to compute recommendations", "namespace", targetNamespace)
+		return ctrl.Result{}, err
+	}
+	projectBudget.Status.LastCheckTime = now.UTC().Format(time.RFC3339)
+	projectBudget.Status.CurrentMonthlyCost = r.monthlyCost(ctx, &projectBudget, podList.Items)
+
+	// Mirror the budget into a native ResourceQuota (opt-in)
+	if err := r.reconcileQuota(ctx, &projectBudget, now); err != nil {
+		logger.Error(err, "Failed to sync ResourceQuota", "namespace", targetNamespace)
+		return ctrl.Result{}, err
+	}
 
 	if err := r.Status().Update(ctx, &projectBudget); err != nil {
 		logger.Error(err, "Failed to update ProjectBudget status")
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	// Keep a record of the overrun (once per crossing, the condition is persisted above)
+	if overBudget && !wasOverBudget {
+		r.recordOverrun(ctx, &projectBudget, usage, ceiling, now)
+	}
+	r.deliverNotifications(ctx, &projectBudget, notifications)
+
+	// Share the right-sizing suggestions as a BudgetRecommendation
+	if err := r.publishRecommendation(ctx, &projectBudget, now); err != nil {
+		logger.Error(err, "Failed to publish BudgetRecommendation")
+		return ctrl.Result{}, err
+	}
+
+	// 7. Enforcement: act on the published plan
+	if enforcementDue && len(plan) > 0 && !enforce {
+		r.Recorder.Eventf(&projectBudget, "Normal", "EnforcementDryRun",
+			"[DRY-RUN] %d enforcement steps planned but not executed, see status.enforcementPlan", len(plan))
+	}
+	if enforce {
+		executed, err := r.executeEnforcement(ctx, &projectBudget, plan)
+		if len(executed) > 0 {
+			r.deliverNotifications(ctx, &projectBudget, []notify.Message{enforcementNotification(&projectBudget, executed, now)})
+		}
+		if err != nil {
+			logger.Error(err, "Failed to execute enforcement plan", "namespace", targetNamespace)
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// reconcileBurst moves the budget through its burst cycle and returns when the next
+// transition is due (0 if none is pending).
+//
+//	Available --(usage over budget)--> Active --(MaxDuration)--> Expired --(Period)--> Available
+func (r *ProjectBudgetReconciler) reconcileBurst(pb *finopsv1.ProjectBudget, overBudget bool, now time.Time) time.Duration {
+	// The period is over: the team gets a fresh burst allowance
+	if pb.Status.BurstStartTime != nil && !now.Before(budget.BurstPeriodEnd(pb)) {
+		pb.Status.BurstStartTime = nil
+		meta.SetStatusCondition(&pb.Status.Conditions, metav1.Condition{
+			Type:               finopsv1.ConditionBurstExpired,
+			Status:             metav1.ConditionFalse,
+			Reason:             "PeriodReset",
+			Message:            "Burst allowance replenished",
+			ObservedGeneration: pb.Generation,
+		})
+	}
+
+	if pb.Status.BurstStartTime == nil {
+		if !overBudget {
+			return 0
+		}
+		pb.Status.BurstStartTime = &metav1.Time{Time: now}
+		r.Recorder.Eventf(pb, "Normal", "BurstStarted", "Namespace %s is using its burst allowance until %s",
+			pb.Spec.TeamName, budget.BurstWindowEnd(pb).UTC().Format(time.RFC3339))
+	}
+
+	windowEnd := budget.BurstWindowEnd(pb)
+	if now.Before(windowEnd) {
+		return windowEnd.Sub(now)
+	}
+
+	// The burst window is used up: new pods are checked against the plain budget again
+	if !meta.IsStatusConditionTrue(pb.Status.Conditions, finopsv1.ConditionBurstExpired) {
+		periodEnd := budget.BurstPeriodEnd(pb)
+		msg := fmt.Sprintf("Burst window of %s expired, budget reverted to %s CPU until %s",
+			pb.Spec.Burst.MaxDuration.Duration, pb.Spec.MaxCpuLimit, periodEnd.UTC().Format(time.RFC3339))
+		meta.SetStatusCondition(&pb.Status.Conditions, metav1.Condition{
+			Type:               finopsv1.ConditionBurstExpired,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WindowElapsed",
+			Message:            msg,
+			ObservedGeneration: pb.Generation,
+		})
+		r.Recorder.Event(pb, "Warning", "BurstExpired", msg)
+	}
+	return budget.BurstPeriodEnd(pb).Sub(now)
+}
+
+// monthlyCost prices the namespace run-rate with the CostModel of the budget. Budgets that
+// don't use money (no maxMonthlyCost nor costModelRef) or can't be priced report "".
+func (r *ProjectBudgetReconciler) monthlyCost(ctx context.Context, pb *finopsv1.ProjectBudget, pods []corev1.Pod) string {
+	if pb.Spec.MaxMonthlyCost == "" && pb.Spec.CostModelRef == "" {
+		return ""
+	}
+	logger := log.FromContext(ctx)
+
+	model, err := cost.Lookup(ctx, r.Client, &pb.Spec)
+	if err != nil {
+		logger.Error(err, "Failed to load CostModel", "costModel", pb.Spec.CostModelRef)
+		return ""
+	}
+	var claims corev1.PersistentVolumeClaimList
+	if err := r.List(ctx, &claims, client.InNamespace(pb.Spec.TeamName)); err != nil {
+		logger.Error(err, "Failed to list claims in namespace", "namespace", pb.Spec.TeamName)
+		return ""
+	}
+	return cost.FormatAmount(model.NamespaceMonthlyCost(pods, claims.Items))
+}
+
+// namespaceUsage lists the pods of the namespace and sums the limits of the active ones.
+func (r *ProjectBudgetReconciler) namespaceUsage(ctx context.Context, namespace string) (corev1.PodList, budget.Resources, error) {
+	ctx, span := tracing.Tracer(r.TracerProvider).Start(ctx, "usage.calculate",
+		trace.WithAttributes(tracing.NamespaceKey.String(namespace)))
+	defer span.End()
+
+	var podList corev1.PodList
+	if err := r.List(ctx, &podList, client.InNamespace(namespace)); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return podList, budget.Resources{}, err
+	}
+	return podList, budget.Usage(podList.Items), nil
+}
+
+// windowName renders an ActiveWindow value for humans.
+func windowName(window string) string {
+	if window == "" {
+		return "default"
+	}
+	return window
+}
+
+// earliestRequeue returns the shortest non-zero delay.
+func earliestRequeue(a, b time.Duration) time.Duration {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// now returns the current time according to the configured clock.
+func (r *ProjectBudgetReconciler) now() time.Time {
+	if r.Clock == nil {
+		return time.Now()
+	}
+	return r.Clock.Now()
+}
+
+// budgetsForPod maps a Pod (or PersistentVolumeClaim) event to the ProjectBudgets governing its namespace.
+func (r *ProjectBudgetReconciler) budgetsForPod(ctx context.Context, obj client.Object) []reconcile.Request {
+	var budgetList finopsv1.ProjectBudgetList
+	if err := r.List(ctx, &budgetList); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list budgets for pod event")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, b := range budgetList.Items {
+		if b.Spec.TeamName == obj.GetNamespace() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: b.Name, Namespace: b.Namespace},
+			})
+		}
+	}
+	return withLoanParties(withAncestors(ctx, requests, budgetList.Items), budgetList.Items)
+}
+
+// budgetsForRelatedBudget maps a ProjectBudget event to the budgets depending on it: its parent
+// (aggregated usage and headroom) and the budgets it lends to or borrows from.
+func (r *ProjectBudgetReconciler) budgetsForRelatedBudget(ctx context.Context, obj client.Object) []reconcile.Request {
+	changed, ok := obj.(*finopsv1.ProjectBudget)
+	if !ok {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	if parentKey, ok := budget.ParentKey(changed); ok {
+		requests = append(requests, reconcile.Request{NamespacedName: parentKey})
+	}
+	var budgetList finopsv1.ProjectBudgetList
+	if err := r.List(ctx, &budgetList); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list budgets for budget event")
+		return requests
+	}
+	parties := append(budget.Lenders(changed, budgetList.Items), budget.Borrowers(changed, budgetList.Items)...)
+	for _, party := range parties {
+		requests = append(requests, reconcile.Request{NamespacedName: budget.Key(party)})
+	}
+	return requests
+}
+
+// budgetsForCostModel maps a CostModel event to the ProjectBudgets priced with it.
+func (r *ProjectBudgetReconciler) budgetsForCostModel(ctx context.Context, obj client.Object) []reconcile.Request {
+	var budgetList finopsv1.ProjectBudgetList
+	if err := r.List(ctx, &budgetList); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list budgets for cost model event")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, b := range budgetList.Items {
+		name := b.Spec.CostModelRef
+		if name == "" {
+			name = finopsv1.DefaultCostModelName
+		}
+		if name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: b.Name, Namespace: b.Namespace},
+			})
+		}
+	}
+	return requests
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ProjectBudgetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&finopsv1.ProjectBudget{}).
+		// Spec changes only: every pass updates the status, which would trigger the next one.
+		// Periodic work is driven by RequeueAfter.
+		For(&finopsv1.ProjectBudget{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// Pods come and go all the time: re-evaluate the budget of their namespace
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.budgetsForPod)).
+		// Storage and price changes move the run-rate cost
+		Watches(&corev1.PersistentVolumeClaim{}, handler.EnqueueRequestsFromMapFunc(r.budgetsForPod)).
+		Watches(&finopsv1.CostModel{}, handler.EnqueueRequestsFromMapFunc(r.budgetsForCostModel)).
+		// Revert manual edits of mirrored quotas
+		Watches(&corev1.ResourceQuota{}, handler.EnqueueRequestsFromMapFunc(r.budgetForQuota)).
+		// Parents aggregate their children and loans depend on both parties
+		// (spec changes only, status updates would loop)
+		Watches(&finopsv1.ProjectBudget{}, handler.EnqueueRequestsFromMapFunc(r.budgetsForRelatedBudget),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// Denials recorded by the webhook are notified to the channels of the budget
+		Watches(&finopsv1.BudgetViolation{}, handler.EnqueueRequestsFromMapFunc(r.budgetsForViolation),
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc:  func(event.UpdateEvent) bool { return false },
+				DeleteFunc:  func(event.DeleteEvent) bool { return false },
+				GenericFunc: func(event.GenericEvent) bool { return false },
+			})).
 		Named("projectbudget").
 		Complete(r)
 }
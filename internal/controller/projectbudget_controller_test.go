@@ -18,11 +18,21 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -82,8 +92,9 @@ var _ = Describe("ProjectBudget Controller", func() {
 		It("should successfully reconcile the resource", func() {
 			By("Reconciling the created resource")
 			controllerReconciler := &ProjectBudgetReconciler{
-				Client: k8sClient,
-				Scheme: k8sClient.Scheme(),
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
 			}
 
 			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
@@ -94,4 +105,775 @@ var _ = Describe("ProjectBudget Controller", func() {
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
 	})
+
+	Context("When a budget has a burst allowance", func() {
+		const (
+			resourceName = "burst-budget"
+			teamName     = "burst-team"
+		)
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+		start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+		BeforeEach(func() {
+			By("creating a namespace with a pod over the budget")
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: teamName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "incident-fix", Namespace: teamName},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{
+					Name:  "app",
+					Image: "nginx",
+					Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("300m"),
+					}},
+				}}},
+			}
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    teamName,
+					MaxCpuLimit: "200m",
+					Burst: &finopsv1.BurstSpec{
+						ExtraCpuLimit: "500m",
+						MaxDuration:   metav1.Duration{Duration: time.Hour},
+						Period:        metav1.Duration{Duration: 24 * time.Hour},
+					},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "incident-fix", Namespace: teamName},
+			})).To(Succeed())
+		})
+
+		It("should record the burst start and expire it after MaxDuration", func() {
+			fakeClock := clocktesting.NewFakePassiveClock(start)
+			recorder := record.NewFakeRecorder(10)
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+				Clock:    fakeClock,
+			}
+
+			By("reconciling while the namespace is over its plain budget")
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Hour))
+
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.BurstStartTime).NotTo(BeNil())
+			Expect(updated.Status.BurstStartTime.Time.Equal(start)).To(BeTrue())
+			Expect(recorder.Events).To(Receive(ContainSubstring("BurstStarted")))
+
+			By("reconciling once the burst window is over")
+			fakeClock.SetTime(start.Add(90 * time.Minute))
+			result, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(24*time.Hour - 90*time.Minute))
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, finopsv1.ConditionBurstExpired)).To(BeTrue())
+			Expect(recorder.Events).To(Receive(ContainSubstring("BurstExpired")))
+
+			By("reconciling after the period is over")
+			fakeClock.SetTime(start.Add(25 * time.Hour))
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(meta.IsStatusConditionFalse(updated.Status.Conditions, finopsv1.ConditionBurstExpired)).To(BeTrue())
+			Expect(updated.Status.BurstStartTime.Time.Equal(start.Add(25 * time.Hour))).To(BeTrue())
+		})
+	})
+
+	Context("When a budget has scheduled windows", func() {
+		const resourceName = "window-budget"
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    "window-team",
+					MaxCpuLimit: "4000m",
+					Schedules: []finopsv1.BudgetWindow{{
+						Name:        "nights",
+						Schedule:    "0 20 * * *",
+						Duration:    metav1.Duration{Duration: 10 * time.Hour},
+						MaxCpuLimit: "200m",
+					}},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+		})
+
+		It("should report the active window and requeue when it closes", func() {
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				Clock:    clocktesting.NewFakePassiveClock(time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC)),
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(8 * time.Hour))
+
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.ActiveWindow).To(Equal("nights"))
+		})
+	})
+	Context("When a budget has a monthly cost limit", func() {
+		const (
+			resourceName = "cost-budget"
+			teamName     = "cost-team"
+		)
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+		storageClass := "gp3"
+
+		BeforeEach(func() {
+			By("creating a namespace with a pod and a claim to price")
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: teamName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, newLimitedPod("api", teamName, "2"))).To(Succeed())
+			Expect(k8sClient.Create(ctx, &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: teamName},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: &storageClass,
+					Resources: corev1.VolumeResourceRequirements{Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("50G"),
+					}},
+				},
+			})).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &finopsv1.CostModel{
+				ObjectMeta: metav1.ObjectMeta{Name: "on-demand"},
+				Spec: finopsv1.CostModelSpec{
+					CpuHourPrice:       "0.05",
+					MemoryGiBHourPrice: "0.01",
+					StorageClasses:     []finopsv1.StorageClassPrice{{Name: storageClass, GBMonthPrice: "0.1"}},
+				},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:       teamName,
+					MaxCpuLimit:    "4",
+					MaxMonthlyCost: "500",
+					CostModelRef:   "on-demand",
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &finopsv1.CostModel{ObjectMeta: metav1.ObjectMeta{Name: "on-demand"}})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: teamName},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: teamName}})).To(Succeed())
+		})
+
+		It("should report the run-rate cost of pods and claims", func() {
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			// 2 vCPU * 0.05 * 730 + 50 GB * 0.1
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.CurrentMonthlyCost).To(Equal("78.00"))
+		})
+	})
+	Context("When a budget tracks cumulative consumption", func() {
+		const (
+			resourceName = "accounting-budget"
+			teamName     = "accounting-team"
+		)
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+
+		BeforeEach(func() {
+			By("creating a namespace with a 2 core pod")
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: teamName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, newLimitedPod("batch", teamName, "2"))).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    teamName,
+					MaxCpuLimit: "4",
+					Accounting: &finopsv1.AccountingSpec{
+						Period:             finopsv1.BillingPeriodMonthly,
+						MaxCpuCoreHours:    "2",
+						BlockWhenExhausted: true,
+					},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "batch", Namespace: teamName}})).To(Succeed())
+		})
+
+		It("should integrate usage, flag the exhausted allowance and reset on a new period", func() {
+			fakeClock := clocktesting.NewFakePassiveClock(time.Date(2026, 3, 31, 22, 0, 0, 0, time.UTC))
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				Clock:    fakeClock,
+			}
+			reconcileAndGet := func() *finopsv1.ProjectBudget {
+				result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(consumptionSampleInterval))
+
+				updated := &finopsv1.ProjectBudget{}
+				Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+				return updated
+			}
+
+			By("starting the period at zero")
+			Expect(reconcileAndGet().Status.Consumption.CpuCoreHours).To(Equal("0.000"))
+
+			By("accruing 2 cores during one hour")
+			fakeClock.SetTime(time.Date(2026, 3, 31, 23, 0, 0, 0, time.UTC))
+			updated := reconcileAndGet()
+			Expect(updated.Status.Consumption.CpuCoreHours).To(Equal("2.000"))
+			Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, finopsv1.ConditionAllowanceExhausted)).To(BeTrue())
+
+			By("forecasting the rest of March from the recorded samples")
+			Expect(updated.Status.Consumption.Samples).To(HaveLen(2))
+			Expect(updated.Status.Consumption.ProjectedCpuCoreHours).To(Equal("4.000"))
+			Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, finopsv1.ConditionProjectedOverrun)).To(BeTrue())
+			Expect(testutil.ToFloat64(projectedSpend.WithLabelValues("default", resourceName, "cpu_core_hours"))).To(Equal(4.0))
+
+			By("closing March and starting April from zero")
+			fakeClock.SetTime(time.Date(2026, 4, 1, 1, 0, 0, 0, time.UTC))
+			updated = reconcileAndGet()
+			Expect(updated.Status.ConsumptionHistory).To(HaveLen(1))
+			Expect(updated.Status.ConsumptionHistory[0].CpuCoreHours).To(Equal("4.000"))
+			Expect(updated.Status.Consumption.PeriodStart.Time).To(BeTemporally("==", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(updated.Status.Consumption.CpuCoreHours).To(Equal("2.000"))
+		})
+	})
+	Context("When consumption is heading over the allowance", func() {
+		const (
+			resourceName = "forecast-budget"
+			teamName     = "forecast-team"
+		)
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+
+		BeforeEach(func() {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: teamName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, newLimitedPod("training", teamName, "4"))).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    teamName,
+					MaxCpuLimit: "8",
+					Accounting: &finopsv1.AccountingSpec{
+						Period:          finopsv1.BillingPeriodMonthly,
+						MaxCpuCoreHours: "1000",
+						ForecastModel:   finopsv1.ForecastEWMA,
+					},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			}))).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "training", Namespace: teamName}})).To(Succeed())
+		})
+
+		It("should warn days before the allowance runs out and drop the gauge with the budget", func() {
+			fakeClock := clocktesting.NewFakePassiveClock(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+			recorder := record.NewFakeRecorder(10)
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+				Clock:    fakeClock,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.SetTime(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			// 96 core-hours consumed on day one; at 4 cores the 1000 allowance runs out on March 11th
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			overrun := meta.FindStatusCondition(updated.Status.Conditions, finopsv1.ConditionProjectedOverrun)
+			Expect(overrun).NotTo(BeNil())
+			Expect(overrun.Status).To(Equal(metav1.ConditionTrue))
+			Expect(overrun.Message).To(ContainSubstring("expected at 2026-03-11T10:00:00Z"))
+			Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, finopsv1.ConditionAllowanceExhausted)).To(BeFalse())
+			Expect(recorder.Events).To(Receive(ContainSubstring("ProjectedOverrun")))
+
+			By("exporting the usage and limit of the budget")
+			Expect(testutil.ToFloat64(budgetLimit.WithLabelValues("default", resourceName, "cpu"))).To(Equal(8.0))
+			Expect(testutil.ToFloat64(budgetUsed.WithLabelValues("default", resourceName, "cpu"))).To(Equal(4.0))
+			Expect(testutil.ToFloat64(budgetUtilization.WithLabelValues("default", resourceName, "cpu"))).To(Equal(0.5))
+			Expect(budgetLimit.DeleteLabelValues("default", resourceName, "memory")).To(BeFalse())
+
+			By("forgetting the metrics of a deleted budget")
+			Expect(k8sClient.Delete(ctx, updated)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(projectedSpend.DeleteLabelValues("default", resourceName, "cpu_core_hours")).To(BeFalse())
+			Expect(budgetUsed.DeleteLabelValues("default", resourceName, "cpu")).To(BeFalse())
+		})
+	})
+	Context("When actual usage metrics are available", func() {
+		const (
+			resourceName = "efficiency-budget"
+			teamName     = "efficiency-team"
+		)
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+
+		BeforeEach(func() {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: teamName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, newLimitedPod("oversized", teamName, "2"))).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: teamName, MaxCpuLimit: "4"},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			}))).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "oversized", Namespace: teamName}})).To(Succeed())
+		})
+
+		It("should report observed usage next to the limits and the efficiency ratio", func() {
+			// PodMetrics are served under the "pods" resource of metrics.k8s.io
+			metricsClient := metricsfake.NewSimpleClientset()
+			podMetricsResource := metricsv1beta1.SchemeGroupVersion.WithResource("pods")
+			Expect(metricsClient.Tracker().Create(podMetricsResource, &metricsv1beta1.PodMetrics{
+				ObjectMeta: metav1.ObjectMeta{Name: "oversized", Namespace: teamName},
+				Containers: []metricsv1beta1.ContainerMetrics{{
+					Name: "app",
+					Usage: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100m"),
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+				}},
+			}, teamName)).To(Succeed())
+			// Metrics of pods that are gone are not counted
+			Expect(metricsClient.Tracker().Create(podMetricsResource, &metricsv1beta1.PodMetrics{
+				ObjectMeta: metav1.ObjectMeta{Name: "deleted", Namespace: teamName},
+				Containers: []metricsv1beta1.ContainerMetrics{{
+					Name:  "app",
+					Usage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("900m")},
+				}},
+			}, teamName)).To(Succeed())
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:        k8sClient,
+				Scheme:        k8sClient.Scheme(),
+				Recorder:      record.NewFakeRecorder(10),
+				MetricsClient: metricsClient,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.CurrentCpuUsage).To(Equal("2000m"))
+			Expect(updated.Status.ObservedCpuUsage).To(Equal("100m"))
+			Expect(updated.Status.ObservedMemoryUsage).To(Equal("64Mi"))
+			Expect(testutil.ToFloat64(efficiencyRatio.WithLabelValues("default", resourceName, "cpu"))).To(Equal(0.05))
+
+			By("forgetting the ratio of a deleted budget")
+			Expect(k8sClient.Delete(ctx, updated)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(efficiencyRatio.DeleteLabelValues("default", resourceName, "cpu")).To(BeFalse())
+			Expect(efficiencyRatio.DeleteLabelValues("default", resourceName, "memory")).To(BeFalse())
+		})
+	})
+	Context("When budgets are nested", func() {
+		const (
+			parentName = "department-budget"
+			childName  = "squad-budget"
+			childTeam  = "squad-team"
+		)
+
+		ctx := context.Background()
+		parentKey := types.NamespacedName{Name: parentName, Namespace: "default"}
+		childKey := types.NamespacedName{Name: childName, Namespace: "default"}
+
+		BeforeEach(func() {
+			By("creating a department budget with one squad under it")
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: childTeam}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: childTeam}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, newLimitedPod("worker", childTeam, "1"))).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: parentName, Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "department-shared", MaxCpuLimit: "8"},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: childName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    childTeam,
+					MaxCpuLimit: "4",
+					ParentRef:   &finopsv1.BudgetReference{Name: parentName},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: childName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: parentName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: childTeam}})).To(Succeed())
+		})
+
+		It("should propagate the usage of the children up to the parent", func() {
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			for _, key := range []types.NamespacedName{parentKey, childKey} {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			parent := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, parentKey, parent)).To(Succeed())
+			Expect(parent.Status.CurrentCpuUsage).To(Equal("0m"))
+			Expect(parent.Status.AggregatedCpuUsage).To(Equal("1000m"))
+
+			child := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, childKey, child)).To(Succeed())
+			Expect(child.Status.CurrentCpuUsage).To(Equal("1000m"))
+			Expect(child.Status.AggregatedCpuUsage).To(BeEmpty())
+		})
+
+		It("should enqueue the parent for pod events in a child namespace", func() {
+			controllerReconciler := &ProjectBudgetReconciler{Client: k8sClient}
+
+			requests := controllerReconciler.budgetsForPod(ctx, newLimitedPod("other", childTeam, "1"))
+			Expect(requests).To(ConsistOf(
+				reconcile.Request{NamespacedName: childKey},
+				reconcile.Request{NamespacedName: parentKey},
+			))
+		})
+	})
+	Context("When a team borrows from another one", func() {
+		const (
+			lenderName   = "lender-team"
+			borrowerName = "borrower-team"
+		)
+
+		ctx := context.Background()
+		lenderKey := types.NamespacedName{Name: lenderName, Namespace: "default"}
+		borrowerKey := types.NamespacedName{Name: borrowerName, Namespace: "default"}
+
+		BeforeEach(func() {
+			By("creating a borrower over its budget and a lender with spare headroom")
+			for _, name := range []string{lenderName, borrowerName} {
+				ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: name}, ns)
+				if err != nil && errors.IsNotFound(err) {
+					Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+				}
+			}
+			Expect(k8sClient.Create(ctx, newLimitedPod("batch", borrowerName, "1500m"))).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: lenderName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    lenderName,
+					MaxCpuLimit: "2",
+					Lending: &finopsv1.LendingSpec{
+						Borrowers:  []finopsv1.BudgetReference{{Name: borrowerName}},
+						MaxLentCpu: "1",
+					},
+				},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: borrowerName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:       borrowerName,
+					MaxCpuLimit:    "1",
+					ValidationMode: finopsv1.DryRunMode,
+					Enforcement:    &finopsv1.EnforcementSpec{Action: finopsv1.EnforcementEvictNewest},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			for _, key := range []types.NamespacedName{lenderKey, borrowerKey} {
+				Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+				})).To(Succeed())
+			}
+			Expect(k8sClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "batch", Namespace: borrowerName}})).To(Succeed())
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "own", Namespace: lenderName},
+			}))).To(Succeed())
+		})
+
+		It("should record the loan in both budgets and reclaim it when the lender needs it", func() {
+			recorder := record.NewFakeRecorder(20)
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+
+			for _, key := range []types.NamespacedName{borrowerKey, lenderKey} {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			borrower := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, borrowerKey, borrower)).To(Succeed())
+			Expect(borrower.Status.BorrowedFrom).To(ConsistOf(finopsv1.Loan{
+				Budget: finopsv1.BudgetReference{Name: lenderName, Namespace: "default"},
+				Cpu:    "500m",
+			}))
+			Expect(borrower.Status.EnforcementPlan).To(BeEmpty())
+
+			lender := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, lenderKey, lender)).To(Succeed())
+			Expect(lender.Status.LentTo).To(HaveLen(1))
+			Expect(lender.Status.LentTo[0].Budget.Name).To(Equal(borrowerName))
+
+			By("letting the lender use most of its own budget")
+			Expect(k8sClient.Create(ctx, newLimitedPod("own", lenderName, "1800m"))).To(Succeed())
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: borrowerKey})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, borrowerKey, borrower)).To(Succeed())
+			Expect(borrower.Status.BorrowedFrom[0].Cpu).To(Equal("200m"))
+			Expect(borrower.Status.EnforcementPlan).NotTo(BeEmpty())
+
+			var reasons []string
+			for len(recorder.Events) > 0 {
+				reasons = append(reasons, <-recorder.Events)
+			}
+			Expect(reasons).To(ContainElement(ContainSubstring("LoanReclaimed")))
+		})
+	})
+	Context("When a budget is mirrored into a ResourceQuota", func() {
+		const (
+			resourceName = "quota-budget"
+			teamName     = "quota-team"
+		)
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+		quotaKey := types.NamespacedName{Name: "finops-" + resourceName, Namespace: teamName}
+
+		BeforeEach(func() {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: teamName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:          teamName,
+					MaxCpuLimit:       "2",
+					MaxMemoryLimit:    "4Gi",
+					SyncResourceQuota: true,
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			pb := &finopsv1.ProjectBudget{}
+			if err := k8sClient.Get(ctx, typeNamespacedName, pb); err == nil {
+				pb.Finalizers = nil
+				Expect(k8sClient.Update(ctx, pb)).To(Succeed())
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, pb))).To(Succeed())
+			}
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: quotaKey.Name, Namespace: quotaKey.Namespace},
+			}))).To(Succeed())
+		})
+
+		It("should create the quota, revert drift and remove it with the budget", func() {
+			recorder := record.NewFakeRecorder(10)
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			quota := &corev1.ResourceQuota{}
+			Expect(k8sClient.Get(ctx, quotaKey, quota)).To(Succeed())
+			Expect(quota.Spec.Hard.Name(corev1.ResourceLimitsCPU, resource.DecimalSI).String()).To(Equal("2"))
+			Expect(quota.Spec.Hard.Name(corev1.ResourceLimitsMemory, resource.BinarySI).String()).To(Equal("4Gi"))
+			Expect(controllerReconciler.budgetForQuota(ctx, quota)).
+				To(ConsistOf(reconcile.Request{NamespacedName: typeNamespacedName}))
+
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Finalizers).To(ContainElement(quotaFinalizer))
+			Expect(updated.Status.ResourceQuota).NotTo(BeNil())
+			Expect(updated.Status.ResourceQuota.Name).To(Equal(quotaKey.Name))
+
+			By("reverting a manual edit of the quota")
+			quota.Spec.Hard[corev1.ResourceLimitsCPU] = resource.MustParse("100")
+			Expect(k8sClient.Update(ctx, quota)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, quotaKey, quota)).To(Succeed())
+			Expect(quota.Spec.Hard.Name(corev1.ResourceLimitsCPU, resource.DecimalSI).String()).To(Equal("2"))
+
+			By("deleting the budget")
+			Expect(testutil.ToFloat64(budgetLimit.WithLabelValues("default", resourceName, "cpu"))).To(Equal(2.0))
+			Expect(k8sClient.Delete(ctx, updated)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, quotaKey, quota))).To(BeTrue())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, updated))).To(BeTrue())
+			Expect(budgetLimit.DeleteLabelValues("default", resourceName, "cpu")).To(BeFalse())
+			Expect(budgetUtilization.DeleteLabelValues("default", resourceName, "memory")).To(BeFalse())
+		})
+
+		It("should remove the quota of a budget switched to DryRun", func() {
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, quotaKey, &corev1.ResourceQuota{})).To(Succeed())
+
+			By("switching the budget to DryRun")
+			pb := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, pb)).To(Succeed())
+			pb.Spec.ValidationMode = finopsv1.DryRunMode
+			Expect(k8sClient.Update(ctx, pb)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, quotaKey, &corev1.ResourceQuota{}))).To(BeTrue())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, pb)).To(Succeed())
+			Expect(pb.Finalizers).NotTo(ContainElement(quotaFinalizer))
+			Expect(pb.Status.ResourceQuota).To(BeNil())
+		})
+
+		It("should only add the headroom lenders leave free", func() {
+			const lenderName = "quota-lender"
+			By("creating a lender capped at 1 CPU that only leaves 300m free")
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: lenderName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: lenderName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			lender := &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: lenderName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    lenderName,
+					MaxCpuLimit: "2",
+					Lending: &finopsv1.LendingSpec{
+						MaxLentCpu: "1",
+						Borrowers:  []finopsv1.BudgetReference{{Name: resourceName}},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, lender)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, lender)
+			pod := newLimitedPod("own", lenderName, "1700m")
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, pod)
+
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			quota := &corev1.ResourceQuota{}
+			Expect(k8sClient.Get(ctx, quotaKey, quota)).To(Succeed())
+			Expect(quota.Spec.Hard.Name(corev1.ResourceLimitsCPU, resource.DecimalSI).String()).To(Equal("2300m"))
+		})
+	})
+
 })
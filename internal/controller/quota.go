@@ -0,0 +1,199 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+const (
+	// quotaFinalizer removes the mirrored ResourceQuota when it can't be garbage collected
+	// (owner references can't cross namespaces)
+	quotaFinalizer = "finops.acasa.acme/resourcequota"
+	// budgetNameLabel and budgetNamespaceLabel point a ResourceQuota back to its budget
+	budgetNameLabel      = "finops.acasa.acme/budget-name"
+	budgetNamespaceLabel = "finops.acasa.acme/budget-namespace"
+)
+
+// +kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch;create;update;patch;delete
+
+// quotaName is the name of the ResourceQuota mirroring pb.
+func quotaName(pb *finopsv1.ProjectBudget) string {
+	return "finops-" + pb.Name
+}
+
+// mirrorsQuota reports whether pb is mirrored into a ResourceQuota. DryRun budgets never are: the
+// API server would enforce limits the operator only reports on.
+func mirrorsQuota(pb *finopsv1.ProjectBudget) bool {
+	return pb.Spec.SyncResourceQuota && pb.Spec.ValidationMode != finopsv1.DryRunMode
+}
+
+// needsQuotaFinalizer reports whether the quota of pb lives outside the budget namespace.
+func needsQuotaFinalizer(pb *finopsv1.ProjectBudget) bool {
+	return mirrorsQuota(pb) && pb.Spec.TeamName != pb.Namespace
+}
+
+// reconcileQuotaFinalizer adds the finalizer to budgets whose quota lives in another namespace,
+// and removes it (with the quota, once mirroring stops) from the others. Metadata is
+// updated before the status is computed, as the update returns the stored status.
+func (r *ProjectBudgetReconciler) reconcileQuotaFinalizer(ctx context.Context, pb *finopsv1.ProjectBudget) error {
+	if needsQuotaFinalizer(pb) {
+		if !controllerutil.AddFinalizer(pb, quotaFinalizer) {
+			return nil
+		}
+		return r.Update(ctx, pb)
+	}
+	if !controllerutil.ContainsFinalizer(pb, quotaFinalizer) {
+		return nil
+	}
+	if !mirrorsQuota(pb) {
+		if err := r.deleteQuota(ctx, pb); err != nil {
+			return err
+		}
+	}
+	controllerutil.RemoveFinalizer(pb, quotaFinalizer)
+	return r.Update(ctx, pb)
+}
+
+// finalizeQuota deletes the quota of a budget being deleted and releases the budget.
+func (r *ProjectBudgetReconciler) finalizeQuota(ctx context.Context, pb *finopsv1.ProjectBudget) error {
+	if !controllerutil.ContainsFinalizer(pb, quotaFinalizer) {
+		return nil
+	}
+	if err := r.deleteQuota(ctx, pb); err != nil {
+		return err
+	}
+	controllerutil.RemoveFinalizer(pb, quotaFinalizer)
+	return r.Update(ctx, pb)
+}
+
+// reconcileQuota creates or corrects the ResourceQuota mirroring pb and reports its usage in
+// status. The quota allows as much as the webhook could admit right now: the limits in force,
+// the burst allowance and the headroom lenders leave free. Without syncResourceQuota, or in DryRun
+// mode, the quota is removed.
+func (r *ProjectBudgetReconciler) reconcileQuota(ctx context.Context, pb *finopsv1.ProjectBudget, now time.Time) error {
+	if !mirrorsQuota(pb) {
+		if pb.Status.ResourceQuota == nil {
+			return nil
+		}
+		pb.Status.ResourceQuota = nil
+		return r.deleteQuota(ctx, pb)
+	}
+
+	hard, err := r.quotaLimits(ctx, pb, now)
+	if err != nil {
+		return err
+	}
+	quota := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: quotaName(pb), Namespace: pb.Spec.TeamName}}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, quota, func() error {
+		if quota.Labels == nil {
+			quota.Labels = map[string]string{}
+		}
+		quota.Labels[budgetNameLabel] = pb.Name
+		quota.Labels[budgetNamespaceLabel] = pb.Namespace
+		quota.Spec.Hard = corev1.ResourceList{
+			corev1.ResourceLimitsCPU: *resource.NewMilliQuantity(hard.CPUMilli, resource.DecimalSI),
+		}
+		if pb.Spec.MaxMemoryLimit != "" {
+			quota.Spec.Hard[corev1.ResourceLimitsMemory] = *resource.NewQuantity(hard.MemoryBytes, resource.BinarySI)
+		}
+		if pb.Namespace == pb.Spec.TeamName {
+			return controllerutil.SetControllerReference(pb, quota, r.Scheme)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if result != controllerutil.OperationResultNone {
+		r.Recorder.Eventf(pb, "Normal", "ResourceQuotaSynced", "ResourceQuota %s/%s %s (limits.cpu %s)",
+			quota.Namespace, quota.Name, result, quota.Spec.Hard.Name(corev1.ResourceLimitsCPU, resource.DecimalSI))
+	}
+
+	pb.Status.ResourceQuota = &finopsv1.ResourceQuotaStatus{
+		Name: quota.Name,
+		Hard: quota.Status.Hard,
+		Used: quota.Status.Used,
+	}
+	return nil
+}
+
+// quotaLimits is the most the webhook could admit in the team namespace right now. Lenders only
+// add the headroom they leave free, as the webhook never borrows more.
+func (r *ProjectBudgetReconciler) quotaLimits(ctx context.Context, pb *finopsv1.ProjectBudget, now time.Time) (budget.Resources, error) {
+	limits, _, err := budget.EffectiveLimits(&pb.Spec, now)
+	if err != nil {
+		return budget.Resources{}, err
+	}
+	burst, err := budget.BurstAllowance(pb, now)
+	if err != nil {
+		return budget.Resources{}, err
+	}
+	hard := limits.Add(burst)
+
+	var budgetList finopsv1.ProjectBudgetList
+	if err := r.List(ctx, &budgetList); err != nil {
+		return budget.Resources{}, err
+	}
+	for _, lender := range budget.Lenders(pb, budgetList.Items) {
+		_, used, err := r.namespaceUsage(ctx, lender.Spec.TeamName)
+		if err != nil {
+			return budget.Resources{}, err
+		}
+		lendable, err := budget.Lendable(lender, used, now)
+		if err != nil {
+			return budget.Resources{}, err
+		}
+		hard = hard.Add(lendable)
+	}
+	return hard, nil
+}
+
+// deleteQuota removes the quota mirroring pb, if this budget created it.
+func (r *ProjectBudgetReconciler) deleteQuota(ctx context.Context, pb *finopsv1.ProjectBudget) error {
+	var quota corev1.ResourceQuota
+	key := types.NamespacedName{Name: quotaName(pb), Namespace: pb.Spec.TeamName}
+	if err := r.Get(ctx, key, &quota); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if quota.Labels[budgetNameLabel] != pb.Name || quota.Labels[budgetNamespaceLabel] != pb.Namespace {
+		return nil // Not ours
+	}
+	return client.IgnoreNotFound(r.Delete(ctx, &quota))
+}
+
+// budgetForQuota maps a ResourceQuota event back to the budget it mirrors, so manual edits
+// are reverted right away.
+func (r *ProjectBudgetReconciler) budgetForQuota(_ context.Context, obj client.Object) []reconcile.Request {
+	name, namespace := obj.GetLabels()[budgetNameLabel], obj.GetLabels()[budgetNamespaceLabel]
+	if name == "" || namespace == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: namespace}}}
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=budgetrecommendations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=budgetrecommendations/status,verbs=get;update;patch
+
+const (
+	// recommendationSampleInterval is the minimum time between two usage samples of a budget
+	recommendationSampleInterval = 5 * time.Minute
+	// minRecommendationSamples is how many samples a workload needs before it gets a recommendation
+	minRecommendationSamples = 3
+	// recommendationPercentile of observed usage the recommended limits are based on
+	recommendationPercentile = 0.95
+	// recommendationHeadroom is added on top of the percentile (15%)
+	recommendationHeadroom = 1.15
+	// defaultRecommendationTopN is the number of workloads published when spec.recommendations.topN is unset
+	defaultRecommendationTopN = 5
+	// maxPersistedSamples bounds the samples of each workload kept in the BudgetRecommendation
+	maxPersistedSamples = 100
+)
+
+// usageSample is the observed usage of one pod at a point in time.
+type usageSample struct {
+	time  time.Time
+	usage budget.Resources
+}
+
+// usageHistory keeps the observed usage of each workload over the recommendation window.
+// A summary is persisted in the BudgetRecommendation and restored after a restart.
+type usageHistory struct {
+	mu         sync.Mutex
+	samples    map[types.NamespacedName]map[workloadKey][]usageSample
+	lastSample map[types.NamespacedName]time.Time
+}
+
+// record adds the pod samples of a budget (at most once per recommendationSampleInterval)
+// and drops those older than window. It returns the samples of each workload in the window.
+func (h *usageHistory) record(budgetKey types.NamespacedName, now time.Time, window time.Duration,
+	current map[workloadKey][]budget.Resources) map[workloadKey][]usageSample {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.samples == nil {
+		h.samples = map[types.NamespacedName]map[workloadKey][]usageSample{}
+		h.lastSample = map[types.NamespacedName]time.Time{}
+	}
+	if h.samples[budgetKey] == nil {
+		h.samples[budgetKey] = map[workloadKey][]usageSample{}
+	}
+	workloads := h.samples[budgetKey]
+
+	if last, ok := h.lastSample[budgetKey]; !ok || now.Sub(last) >= recommendationSampleInterval {
+		h.lastSample[budgetKey] = now
+		for key, pods := range current {
+			for _, usage := range pods {
+				workloads[key] = append(workloads[key], usageSample{time: now, usage: usage})
+			}
+		}
+	}
+
+	out := make(map[workloadKey][]usageSample, len(workloads))
+	for key, samples := range workloads {
+		kept := samples[:0]
+		for _, s := range samples {
+			if now.Sub(s.time) <= window {
+				kept = append(kept, s)
+			}
+		}
+		if len(kept) == 0 {
+			delete(workloads, key)
+			continue
+		}
+		workloads[key] = kept
+		out[key] = append([]usageSample(nil), kept...)
+	}
+	return out
+}
+
+// known reports whether the history of a budget was started (or restored) by this process.
+func (h *usageHistory) known(budgetKey types.NamespacedName) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, ok := h.samples[budgetKey]
+	return ok
+}
+
+// restore seeds the history of a budget with the samples persisted before a restart. The next
+// reconcile samples again right away.
+func (h *usageHistory) restore(budgetKey types.NamespacedName, persisted []finopsv1.WorkloadUsageHistory) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.samples == nil {
+		h.samples = map[types.NamespacedName]map[workloadKey][]usageSample{}
+		h.lastSample = map[types.NamespacedName]time.Time{}
+	}
+	workloads := map[workloadKey][]usageSample{}
+	for _, w := range persisted {
+		key := workloadKey{Kind: w.Kind, Name: w.Name}
+		for _, s := range w.Samples {
+			workloads[key] = append(workloads[key], usageSample{
+				time:  s.Time.Time,
+				usage: budget.Resources{CPUMilli: s.CpuMilli, MemoryBytes: s.MemoryBytes},
+			})
+		}
+	}
+	h.samples[budgetKey] = workloads
+}
+
+// summary returns the samples of a budget to persist: at most maxPersistedSamples per workload,
+// spread evenly over the window, in order of workload.
+func (h *usageHistory) summary(budgetKey types.NamespacedName) []finopsv1.WorkloadUsageHistory {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var out []finopsv1.WorkloadUsageHistory
+	for key, samples := range h.samples[budgetKey] {
+		n := min(len(samples), maxPersistedSamples)
+		w := finopsv1.WorkloadUsageHistory{Kind: key.Kind, Name: key.Name, Samples: make([]finopsv1.UsageSample, 0, n)}
+		for i := range n {
+			s := samples[i*len(samples)/n]
+			w.Samples = append(w.Samples, finopsv1.UsageSample{
+				// Stored with a precision of a second: keep the summary stable across reads
+				Time:        metav1.NewTime(s.time.Truncate(time.Second)),
+				CpuMilli:    s.usage.CPUMilli,
+				MemoryBytes: s.usage.MemoryBytes,
+			})
+		}
+		out = append(out, w)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Kind != out[j].Kind {
+			return out[i].Kind < out[j].Kind
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// forget drops the history of a budget.
+func (h *usageHistory) forget(budgetKey types.NamespacedName) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.samples, budgetKey)
+	delete(h.lastSample, budgetKey)
+}
+
+// reconcileRecommendations compares the p95 of the observed usage of each workload with its
+// limits and publishes the most over-provisioned ones in status. perPod is the observed usage
+// of the active pods (nil when usage metrics are unavailable).
+func (r *ProjectBudgetReconciler) reconcileRecommendations(ctx context.Context, pb *finopsv1.ProjectBudget,
+	pods []corev1.Pod, perPod map[string]budget.Resources, now time.Time) error {
+
+	budgetKey := types.NamespacedName{Name: pb.Name, Namespace: pb.Namespace}
+	spec := pb.Spec.Recommendations
+	if spec == nil {
+		r.history.forget(budgetKey)
+		pb.Status.Recommendations, pb.Status.PotentialCpuSavings, pb.Status.PotentialMemorySavings = nil, "", ""
+		return nil
+	}
+	if perPod == nil {
+		// Keep the last recommendations until metrics come back
+		return nil
+	}
+
+	// Group the active pods by workload: their limits and their observed usage
+	limits := map[workloadKey]budget.Resources{}
+	replicas := map[workloadKey]int32{}
+	current := map[workloadKey][]budget.Resources{}
+	for i := range pods {
+		pod := &pods[i]
+		usage, observed := perPod[pod.Name]
+		if !budget.IsActive(pod) || !observed {
+			continue
+		}
+		key, err := r.recommendationTarget(ctx, pod)
+		if err != nil {
+			return err
+		}
+		limits[key] = maxResources(limits[key], budget.PodResources(pod))
+		replicas[key]++
+		current[key] = append(current[key], usage)
+	}
+
+	if !r.history.known(budgetKey) {
+		// Pick up the samples persisted before the controller (re)started
+		var published finopsv1.BudgetRecommendation
+		if err := r.Get(ctx, budgetKey, &published); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		r.history.restore(budgetKey, published.Status.History)
+	}
+	history := r.history.record(budgetKey, now, recommendationWindow(spec), current)
+
+	type candidate struct {
+		rec      finopsv1.WorkloadRecommendation
+		cpuSaved int64
+	}
+	var candidates []candidate
+	var totalCpu, totalMem int64
+	for key, samples := range history {
+		limit, running := limits[key], replicas[key]
+		if running == 0 || len(samples) < minRecommendationSamples {
+			continue
+		}
+		recommended := recommendedLimits(samples)
+
+		var cpuSaved, memSaved int64
+		if limit.CPUMilli > recommended.CPUMilli {
+			cpuSaved = (limit.CPUMilli - recommended.CPUMilli) * int64(running)
+		}
+		if limit.MemoryBytes > recommended.MemoryBytes {
+			memSaved = (limit.MemoryBytes - recommended.MemoryBytes) * int64(running)
+		}
+		if cpuSaved == 0 && memSaved == 0 {
+			continue
+		}
+		totalCpu += cpuSaved
+		totalMem += memSaved
+
+		rec := finopsv1.WorkloadRecommendation{
+			Kind:                key.Kind,
+			Name:                key.Name,
+			Replicas:            running,
+			CurrentCpuLimit:     fmt.Sprintf("%dm", limit.CPUMilli),
+			RecommendedCpuLimit: fmt.Sprintf("%dm", min(recommended.CPUMilli, limit.CPUMilli)),
+			CpuSavings:          fmt.Sprintf("%dm", cpuSaved),
+		}
+		if limit.MemoryBytes > 0 {
+			rec.CurrentMemoryLimit = memoryString(limit.MemoryBytes)
+			rec.RecommendedMemoryLimit = memoryString(min(recommended.MemoryBytes, limit.MemoryBytes))
+			rec.MemorySavings = memoryString(memSaved)
+		}
+		candidates = append(candidates, candidate{rec: rec, cpuSaved: cpuSaved})
+	}
+
+	// Largest CPU savings first; the rest of the order only keeps status stable
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.cpuSaved != b.cpuSaved {
+			return a.cpuSaved > b.cpuSaved
+		}
+		if a.rec.Kind != b.rec.Kind {
+			return a.rec.Kind < b.rec.Kind
+		}
+		return a.rec.Name < b.rec.Name
+	})
+	var recommendations []finopsv1.WorkloadRecommendation
+	for i := 0; i < len(candidates) && i < recommendationTopN(spec); i++ {
+		recommendations = append(recommendations, candidates[i].rec)
+	}
+
+	pb.Status.Recommendations = recommendations
+	pb.Status.PotentialCpuSavings = fmt.Sprintf("%dm", totalCpu)
+	pb.Status.PotentialMemorySavings = memoryString(totalMem)
+	return nil
+}
+
+// publishRecommendation mirrors the recommendations of a budget into its BudgetRecommendation,
+// owned by the budget so it is garbage collected with it, with the usage history behind them.
+func (r *ProjectBudgetReconciler) publishRecommendation(ctx context.Context, pb *finopsv1.ProjectBudget, now time.Time) error {
+	rec := &finopsv1.BudgetRecommendation{ObjectMeta: metav1.ObjectMeta{Name: pb.Name, Namespace: pb.Namespace}}
+	if pb.Spec.Recommendations == nil {
+		return client.IgnoreNotFound(r.Delete(ctx, rec))
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, rec, func() error {
+		rec.Spec.BudgetName = pb.Name
+		return controllerutil.SetControllerReference(pb, rec, r.Scheme)
+	}); err != nil {
+		return err
+	}
+
+	desired := finopsv1.BudgetRecommendationStatus{
+		TeamName:               pb.Spec.TeamName,
+		Window:                 metav1.Duration{Duration: recommendationWindow(pb.Spec.Recommendations)},
+		GeneratedAt:            rec.Status.GeneratedAt,
+		Workloads:              pb.Status.Recommendations,
+		PotentialCpuSavings:    pb.Status.PotentialCpuSavings,
+		PotentialMemorySavings: pb.Status.PotentialMemorySavings,
+		History:                r.history.summary(types.NamespacedName{Name: pb.Name, Namespace: pb.Namespace}),
+	}
+	if equality.Semantic.DeepEqual(rec.Status, desired) {
+		return nil
+	}
+	// New samples alone don't make new recommendations
+	published, recommended := rec.Status, desired
+	published.History, recommended.History = nil, nil
+	if !equality.Semantic.DeepEqual(published, recommended) {
+		desired.GeneratedAt = &metav1.Time{Time: now}
+	}
+	rec.Status = desired
+	if err := r.Status().Update(ctx, rec); err != nil && !apierrors.IsConflict(err) {
+		return err
+	}
+	return nil
+}
+
+// recommendationTarget is the workload a pod is sized through: its Deployment or StatefulSet,
+// otherwise its controller (e.g., a Job) or the pod itself.
+func (r *ProjectBudgetReconciler) recommendationTarget(ctx context.Context, pod *corev1.Pod) (workloadKey, error) {
+	key, found, err := r.workloadOf(ctx, pod)
+	if err != nil || found {
+		return key, err
+	}
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		return workloadKey{Kind: owner.Kind, Name: owner.Name}, nil
+	}
+	return workloadKey{Kind: "Pod", Name: pod.Name}, nil
+}
+
+// recommendedLimits is the p95 of the samples plus headroom, rounded up (CPU to the
+// millicore, Memory to the MiB).
+func recommendedLimits(samples []usageSample) budget.Resources {
+	cpu := make([]int64, 0, len(samples))
+	mem := make([]int64, 0, len(samples))
+	for _, s := range samples {
+		cpu = append(cpu, s.usage.CPUMilli)
+		mem = append(mem, s.usage.MemoryBytes)
+	}
+	const mebibyte = 1 << 20
+	cpuMilli := int64(math.Ceil(float64(percentile(cpu, recommendationPercentile)) * recommendationHeadroom))
+	memMiB := int64(math.Ceil(float64(percentile(mem, recommendationPercentile)) * recommendationHeadroom / mebibyte))
+	return budget.Resources{CPUMilli: max(cpuMilli, 1), MemoryBytes: max(memMiB, 1) * mebibyte}
+}
+
+// percentile returns the nearest-rank percentile p (0-1] of values.
+func percentile(values []int64, p float64) int64 {
+	sorted := append([]int64(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
+
+// maxResources returns the largest amount of each dimension.
+func maxResources(a, b budget.Resources) budget.Resources {
+	return budget.Resources{CPUMilli: max(a.CPUMilli, b.CPUMilli), MemoryBytes: max(a.MemoryBytes, b.MemoryBytes)}
+}
+
+// memoryString renders bytes as a binary quantity (e.g., "512Mi").
+func memoryString(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
+
+// recommendationWindow returns the configured window (24h by default).
+func recommendationWindow(spec *finopsv1.RecommendationSpec) time.Duration {
+	if spec.Window.Duration <= 0 {
+		return 24 * time.Hour
+	}
+	return spec.Window.Duration
+}
+
+// recommendationTopN returns how many workloads are published.
+func recommendationTopN(spec *finopsv1.RecommendationSpec) int {
+	if spec.TopN <= 0 {
+		return defaultRecommendationTopN
+	}
+	return int(spec.TopN)
+}
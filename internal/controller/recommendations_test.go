@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+var _ = Describe("ProjectBudget Recommendations", func() {
+	Context("When computing percentiles", func() {
+		It("should use the nearest rank", func() {
+			values := make([]int64, 0, 20)
+			for i := int64(20); i >= 1; i-- {
+				values = append(values, i*10)
+			}
+			Expect(percentile(values, 0.95)).To(Equal(int64(190)))
+			Expect(percentile([]int64{42}, 0.95)).To(Equal(int64(42)))
+		})
+
+		It("should add headroom on top of the p95", func() {
+			samples := []usageSample{
+				{usage: budget.Resources{CPUMilli: 100, MemoryBytes: 100 << 20}},
+				{usage: budget.Resources{CPUMilli: 200, MemoryBytes: 200 << 20}},
+			}
+			Expect(recommendedLimits(samples)).To(Equal(budget.Resources{CPUMilli: 230, MemoryBytes: 230 << 20}))
+		})
+	})
+
+	Context("When persisting the usage history", func() {
+		It("should keep a bounded summary that restores the samples", func() {
+			budgetKey := types.NamespacedName{Name: "summary", Namespace: "default"}
+			key := workloadKey{Kind: "Deployment", Name: "web"}
+			start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			h := &usageHistory{}
+			for i := range 2 * maxPersistedSamples {
+				h.record(budgetKey, start.Add(time.Duration(i)*recommendationSampleInterval), time.Hour*24*7,
+					map[workloadKey][]budget.Resources{key: {{CPUMilli: int64(i), MemoryBytes: 1 << 20}}})
+			}
+
+			summary := h.summary(budgetKey)
+			Expect(summary).To(HaveLen(1))
+			Expect(summary[0].Kind).To(Equal("Deployment"))
+			Expect(summary[0].Samples).To(HaveLen(maxPersistedSamples))
+			Expect(summary[0].Samples[0].CpuMilli).To(Equal(int64(0)))
+			Expect(summary[0].Samples[1].CpuMilli).To(Equal(int64(2)))
+
+			restored := &usageHistory{}
+			Expect(restored.known(budgetKey)).To(BeFalse())
+			restored.restore(budgetKey, summary)
+			Expect(restored.known(budgetKey)).To(BeTrue())
+			Expect(restored.summary(budgetKey)).To(Equal(summary))
+		})
+	})
+
+	Context("When a workload uses a fraction of its limits", func() {
+		const (
+			resourceName = "rightsizing-budget"
+			teamName     = "rightsizing-team"
+		)
+
+		ctx := context.Background()
+		typeNamespacedName := types.NamespacedName{Name: resourceName, Namespace: "default"}
+
+		BeforeEach(func() {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: teamName}}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: teamName}, ns)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			}
+			pod := newLimitedPod("reporting", teamName, "2")
+			pod.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory] = resource.MustParse("1Gi")
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+
+			Expect(k8sClient.Create(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:        teamName,
+					MaxCpuLimit:     "4",
+					Recommendations: &finopsv1.RecommendationSpec{Window: metav1.Duration{Duration: time.Hour}, TopN: 3},
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &finopsv1.BudgetRecommendation{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+			})).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "reporting", Namespace: teamName}})).To(Succeed())
+		})
+
+		It("should recommend smaller limits once enough usage was observed", func() {
+			metricsClient := metricsfake.NewSimpleClientset()
+			Expect(metricsClient.Tracker().Create(metricsv1beta1.SchemeGroupVersion.WithResource("pods"), &metricsv1beta1.PodMetrics{
+				ObjectMeta: metav1.ObjectMeta{Name: "reporting", Namespace: teamName},
+				Containers: []metricsv1beta1.ContainerMetrics{{
+					Name: "app",
+					Usage: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("200m"),
+						corev1.ResourceMemory: resource.MustParse("200Mi"),
+					},
+				}},
+			}, teamName)).To(Succeed())
+
+			fakeClock := clocktesting.NewFakePassiveClock(time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC))
+			controllerReconciler := &ProjectBudgetReconciler{
+				Client:        k8sClient,
+				Scheme:        k8sClient.Scheme(),
+				Recorder:      record.NewFakeRecorder(10),
+				Clock:         fakeClock,
+				MetricsClient: metricsClient,
+			}
+
+			By("collecting samples over the window")
+			for i := 0; i < minRecommendationSamples; i++ {
+				fakeClock.SetTime(fakeClock.Now().Add(recommendationSampleInterval))
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			updated := &finopsv1.ProjectBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.Recommendations).To(ConsistOf(finopsv1.WorkloadRecommendation{
+				Kind:                   "Pod",
+				Name:                   "reporting",
+				Replicas:               1,
+				CurrentCpuLimit:        "2000m",
+				RecommendedCpuLimit:    "230m",
+				CurrentMemoryLimit:     "1Gi",
+				RecommendedMemoryLimit: "230Mi",
+				CpuSavings:             "1770m",
+				MemorySavings:          "794Mi",
+			}))
+			Expect(updated.Status.PotentialCpuSavings).To(Equal("1770m"))
+
+			By("publishing them as a BudgetRecommendation owned by the budget")
+			rec := &finopsv1.BudgetRecommendation{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, rec)).To(Succeed())
+			Expect(rec.Spec.BudgetName).To(Equal(resourceName))
+			Expect(rec.Status.Workloads).To(Equal(updated.Status.Recommendations))
+			Expect(rec.Status.GeneratedAt).NotTo(BeNil())
+			Expect(metav1.IsControlledBy(rec, updated)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// recordOverrun keeps a BudgetViolation in the team namespace when it goes over budget (e.g.,
+// after the budget was lowered), until the TTL expires. Failures are only logged.
+func (r *ProjectBudgetReconciler) recordOverrun(ctx context.Context, pb *finopsv1.ProjectBudget,
+	usage, ceiling budget.Resources, now time.Time) {
+	if r.ViolationTTL <= 0 {
+		return
+	}
+
+	var resources []finopsv1.ViolatedResource
+	if usage.CPUMilli > ceiling.CPUMilli {
+		resources = append(resources, finopsv1.ViolatedResource{
+			Resource: "cpu",
+			Used:     resource.NewMilliQuantity(usage.CPUMilli, resource.DecimalSI).String(),
+			Limit:    resource.NewMilliQuantity(ceiling.CPUMilli, resource.DecimalSI).String(),
+		})
+	}
+	if ceiling.MemoryBytes > 0 && usage.MemoryBytes > ceiling.MemoryBytes {
+		resources = append(resources, finopsv1.ViolatedResource{
+			Resource: "memory",
+			Used:     resource.NewQuantity(usage.MemoryBytes, resource.BinarySI).String(),
+			Limit:    resource.NewQuantity(ceiling.MemoryBytes, resource.BinarySI).String(),
+		})
+	}
+	message := ""
+	if cond := meta.FindStatusCondition(pb.Status.Conditions, finopsv1.ConditionOverBudget); cond != nil {
+		message = cond.Message
+	}
+
+	record := &finopsv1.BudgetViolation{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: pb.Name + "-",
+			Namespace:    pb.Spec.TeamName,
+			Labels:       map[string]string{finopsv1.BudgetNameLabel: pb.Name},
+		},
+		Spec: finopsv1.BudgetViolationSpec{
+			BudgetKind: "ProjectBudget",
+			BudgetName: pb.Name,
+			Source:     finopsv1.ViolationSourceController,
+			Action:     finopsv1.ViolationDetected,
+			Reason:     string(finopsv1.ReasonBudgetExceeded),
+			Message:    message,
+			Resources:  resources,
+			ExpiresAt:  metav1.NewTime(now.Add(r.ViolationTTL)),
+		},
+	}
+	if err := r.Create(ctx, record); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to record BudgetViolation", "namespace", pb.Spec.TeamName)
+	}
+}
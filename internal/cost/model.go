@@ -0,0 +1,180 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cost turns reserved Kubernetes resources into money using a CostModel.
+package cost
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// HoursPerMonth is the average number of hours in a month (365 * 24 / 12).
+const HoursPerMonth = 730
+
+const (
+	bytesPerGiB = 1 << 30
+	bytesPerGB  = 1e9
+)
+
+// Model holds the parsed prices of a CostModel.
+type Model struct {
+	Currency       string
+	CPUHour        float64
+	MemoryGiBHour  float64
+	GPUHour        float64
+	GPUResource    corev1.ResourceName
+	StorageGBMonth map[string]float64
+}
+
+// NewModel parses the prices of a CostModel spec.
+func NewModel(spec *finopsv1.CostModelSpec) (*Model, error) {
+	m := &Model{
+		Currency:       spec.Currency,
+		GPUResource:    corev1.ResourceName(spec.GpuResourceName),
+		StorageGBMonth: make(map[string]float64, len(spec.StorageClasses)),
+	}
+	if m.GPUResource == "" {
+		m.GPUResource = "nvidia.com/gpu"
+	}
+
+	var err error
+	if m.CPUHour, err = parsePrice("cpuHourPrice", spec.CpuHourPrice); err != nil {
+		return nil, err
+	}
+	if m.MemoryGiBHour, err = parsePrice("memoryGiBHourPrice", spec.MemoryGiBHourPrice); err != nil {
+		return nil, err
+	}
+	if m.GPUHour, err = parsePrice("gpuHourPrice", spec.GpuHourPrice); err != nil {
+		return nil, err
+	}
+	for _, sc := range spec.StorageClasses {
+		price, err := parsePrice("storageClasses["+sc.Name+"]", sc.GBMonthPrice)
+		if err != nil {
+			return nil, err
+		}
+		m.StorageGBMonth[sc.Name] = price
+	}
+	return m, nil
+}
+
+// Lookup fetches and parses the CostModel referenced by a budget ("default" if unset).
+func Lookup(ctx context.Context, c client.Reader, spec *finopsv1.ProjectBudgetSpec) (*Model, error) {
+	name := spec.CostModelRef
+	if name == "" {
+		name = finopsv1.DefaultCostModelName
+	}
+
+	var cm finopsv1.CostModel
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, &cm); err != nil {
+		return nil, err
+	}
+	return NewModel(&cm.Spec)
+}
+
+// ComputeMonthlyCost prices CPU, Memory and GPU limits running for a whole month.
+func (m *Model) ComputeMonthlyCost(res budget.Resources, gpus int64) float64 {
+	hourly := float64(res.CPUMilli)/1000*m.CPUHour +
+		float64(res.MemoryBytes)/bytesPerGiB*m.MemoryGiBHour +
+		float64(gpus)*m.GPUHour
+	return hourly * HoursPerMonth
+}
+
+// PodMonthlyCost projects the monthly cost of a pod: its compute limits plus the storage of
+// its generic ephemeral volumes. Claims referenced by name already exist and are priced
+// with the namespace (see NamespaceMonthlyCost).
+func (m *Model) PodMonthlyCost(pod *corev1.Pod) float64 {
+	total := m.ComputeMonthlyCost(budget.PodResources(pod), m.podGPUs(pod))
+	for _, vol := range pod.Spec.Volumes {
+		if vol.Ephemeral == nil || vol.Ephemeral.VolumeClaimTemplate == nil {
+			continue
+		}
+		total += m.claimSpecMonthlyCost(&vol.Ephemeral.VolumeClaimTemplate.Spec)
+	}
+	return total
+}
+
+// ClaimMonthlyCost prices the storage requested by a PersistentVolumeClaim.
+func (m *Model) ClaimMonthlyCost(pvc *corev1.PersistentVolumeClaim) float64 {
+	return m.claimSpecMonthlyCost(&pvc.Spec)
+}
+
+// NamespaceMonthlyCost is the run-rate of a namespace: active pods plus all its claims.
+func (m *Model) NamespaceMonthlyCost(pods []corev1.Pod, claims []corev1.PersistentVolumeClaim) float64 {
+	var total float64
+	for i := range pods {
+		if budget.IsActive(&pods[i]) {
+			total += m.ComputeMonthlyCost(budget.PodResources(&pods[i]), m.podGPUs(&pods[i]))
+		}
+	}
+	for i := range claims {
+		total += m.ClaimMonthlyCost(&claims[i])
+	}
+	return total
+}
+
+// podGPUs counts the GPUs in the limits of a pod.
+func (m *Model) podGPUs(pod *corev1.Pod) int64 {
+	var gpus int64
+	for _, c := range pod.Spec.Containers {
+		if q, ok := c.Resources.Limits[m.GPUResource]; ok {
+			gpus += q.Value()
+		}
+	}
+	return gpus
+}
+
+// claimSpecMonthlyCost prices the storage request of a claim by its StorageClass.
+func (m *Model) claimSpecMonthlyCost(spec *corev1.PersistentVolumeClaimSpec) float64 {
+	if spec.StorageClassName == nil {
+		return 0
+	}
+	storage, ok := spec.Resources.Requests[corev1.ResourceStorage]
+	if !ok {
+		return 0
+	}
+	return float64(storage.Value()) / bytesPerGB * m.StorageGBMonth[*spec.StorageClassName]
+}
+
+// ParseAmount parses a money amount such as MaxMonthlyCost.
+func ParseAmount(amount string) (float64, error) {
+	return strconv.ParseFloat(amount, 64)
+}
+
+// FormatAmount renders a money amount with cents precision.
+func FormatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// parsePrice parses an optional price field (empty means free).
+func parsePrice(field, value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	price, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", field, value, err)
+	}
+	return price, nil
+}
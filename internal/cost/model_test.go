@@ -0,0 +1,109 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+var _ = Describe("Model", func() {
+	var model *Model
+
+	BeforeEach(func() {
+		var err error
+		model, err = NewModel(&finopsv1.CostModelSpec{
+			Currency:           "USD",
+			CpuHourPrice:       "0.04",
+			MemoryGiBHourPrice: "0.005",
+			GpuHourPrice:       "2",
+			StorageClasses:     []finopsv1.StorageClassPrice{{Name: "gp3", GBMonthPrice: "0.1"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should price CPU, Memory and GPU limits over 730 hours", func() {
+		pod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name: "train",
+			Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+				"nvidia.com/gpu":      resource.MustParse("1"),
+			}},
+		}}}}
+
+		// (2 * 0.04 + 4 * 0.005 + 1 * 2) * 730
+		Expect(model.PodMonthlyCost(pod)).To(BeNumerically("~", 1533, 0.001))
+	})
+
+	It("Should price generic ephemeral volumes of a new pod by StorageClass", func() {
+		gp3 := "gp3"
+		pod := &corev1.Pod{Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+			Name: "scratch",
+			VolumeSource: corev1.VolumeSource{Ephemeral: &corev1.EphemeralVolumeSource{
+				VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: &gp3,
+					Resources: corev1.VolumeResourceRequirements{Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("100G"),
+					}},
+				}},
+			}},
+		}}}}
+
+		Expect(model.PodMonthlyCost(pod)).To(BeNumerically("~", 10, 0.001))
+	})
+
+	It("Should ignore finished pods and claims of unpriced classes in the run-rate", func() {
+		standard := "standard"
+		pods := []corev1.Pod{
+			{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				}}}},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "job", Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")},
+				}}}},
+				Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+			},
+		}
+		claims := []corev1.PersistentVolumeClaim{{
+			ObjectMeta: metav1.ObjectMeta{Name: "data"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: &standard,
+				Resources: corev1.VolumeResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("1Ti"),
+				}},
+			},
+		}}
+
+		Expect(FormatAmount(model.NamespaceMonthlyCost(pods, claims))).To(Equal("29.20"))
+	})
+
+	It("Should reject malformed prices", func() {
+		_, err := NewModel(&finopsv1.CostModelSpec{CpuHourPrice: "cheap", MemoryGiBHourPrice: "0"})
+		Expect(err).To(MatchError(ContainSubstring("cpuHourPrice")))
+	})
+})
@@ -0,0 +1,166 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package importer turns native ResourceQuota objects into equivalent ProjectBudgets, to
+// migrate existing namespaces to the operator.
+package importer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// ImportedFromAnnotation lists the objects a generated budget was built from.
+const ImportedFromAnnotation = "finops.acasa.acme/imported-from"
+
+// Options tunes the generated budgets.
+type Options struct {
+	// BudgetNamespace is where the budgets are created. Empty puts each budget in the
+	// namespace it governs.
+	BudgetNamespace string
+}
+
+// Result is the budget generated for one namespace (nil if none could be), with notes
+// about everything that could not be carried over.
+type Result struct {
+	Namespace string
+	Budget    *finopsv1.ProjectBudget
+	Notes     []string
+}
+
+// Import builds one DryRun ProjectBudget per namespace with a ResourceQuota. When a namespace has
+// several quotas, the tightest limit of each resource wins. Results are sorted by namespace.
+func Import(quotas []corev1.ResourceQuota, limitRanges []corev1.LimitRange, opts Options) []Result {
+	byNamespace := map[string]*Result{}
+	result := func(ns string) *Result {
+		if byNamespace[ns] == nil {
+			byNamespace[ns] = &Result{Namespace: ns}
+		}
+		return byNamespace[ns]
+	}
+
+	hard := map[string]corev1.ResourceList{}
+	sources := map[string][]string{}
+	for _, q := range quotas {
+		r := result(q.Namespace)
+		sources[q.Namespace] = append(sources[q.Namespace], "ResourceQuota/"+q.Name)
+		if len(q.Spec.Scopes) > 0 || q.Spec.ScopeSelector != nil {
+			r.Notes = append(r.Notes, fmt.Sprintf("ResourceQuota/%s: scopes have no equivalent, the budget covers every pod", q.Name))
+		}
+		if hard[q.Namespace] == nil {
+			hard[q.Namespace] = corev1.ResourceList{}
+		}
+		for name, quantity := range q.Spec.Hard {
+			if current, ok := hard[q.Namespace][name]; !ok || quantity.Cmp(current) < 0 {
+				hard[q.Namespace][name] = quantity
+			}
+		}
+	}
+	for _, lr := range limitRanges {
+		result(lr.Namespace).Notes = append(result(lr.Namespace).Notes,
+			fmt.Sprintf("LimitRange/%s: per-container defaults and bounds have no equivalent", lr.Name))
+	}
+
+	for ns, r := range byNamespace {
+		if hard[ns] == nil {
+			r.Notes = append(r.Notes, "no ResourceQuota, no budget generated")
+			continue
+		}
+		r.Budget, r.Notes = buildBudget(ns, hard[ns], sources[ns], opts, r.Notes)
+	}
+
+	results := make([]Result, 0, len(byNamespace))
+	for _, r := range byNamespace {
+		results = append(results, *r)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Namespace < results[j].Namespace })
+	return results
+}
+
+// buildBudget maps the hard limits of a namespace to a budget. Limits are preferred; requests
+// are used (with a note) when a quota only caps those.
+func buildBudget(ns string, hard corev1.ResourceList, sources []string, opts Options, notes []string) (*finopsv1.ProjectBudget, []string) {
+	cpu, cpuFrom := pick(hard, corev1.ResourceLimitsCPU, corev1.ResourceRequestsCPU, corev1.ResourceCPU)
+	memory, memoryFrom := pick(hard, corev1.ResourceLimitsMemory, corev1.ResourceRequestsMemory, corev1.ResourceMemory)
+	for name := range hard {
+		if name != cpuFrom && name != memoryFrom {
+			notes = append(notes, fmt.Sprintf("%s has no equivalent", name))
+		}
+	}
+	sort.Strings(notes)
+
+	if cpu == nil {
+		return nil, append(notes, "no CPU quota, no budget generated (maxCpuLimit is required)")
+	}
+	if cpuFrom != corev1.ResourceLimitsCPU {
+		notes = append(notes, fmt.Sprintf("maxCpuLimit taken from %s: budgets count limits, not requests", cpuFrom))
+	}
+
+	budgetNamespace := opts.BudgetNamespace
+	if budgetNamespace == "" {
+		budgetNamespace = ns
+	}
+	pb := &finopsv1.ProjectBudget{
+		TypeMeta: metav1.TypeMeta{APIVersion: finopsv1.GroupVersion.String(), Kind: "ProjectBudget"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ns,
+			Namespace:   budgetNamespace,
+			Annotations: map[string]string{ImportedFromAnnotation: joinSources(sources)},
+		},
+		Spec: finopsv1.ProjectBudgetSpec{
+			TeamName:       ns,
+			MaxCpuLimit:    fmt.Sprintf("%dm", cpu.MilliValue()),
+			ValidationMode: finopsv1.DryRunMode,
+		},
+	}
+	if memory != nil {
+		if memoryFrom != corev1.ResourceLimitsMemory {
+			notes = append(notes, fmt.Sprintf("maxMemoryLimit taken from %s: budgets count limits, not requests", memoryFrom))
+		}
+		// maxMemoryLimit only accepts whole Mi/Gi
+		const mebibyte = 1 << 20
+		mib := (memory.Value() + mebibyte - 1) / mebibyte
+		if mib*mebibyte != memory.Value() {
+			notes = append(notes, fmt.Sprintf("%s of %s rounded up to %dMi", memoryFrom, memory.String(), mib))
+		}
+		pb.Spec.MaxMemoryLimit = fmt.Sprintf("%dMi", mib)
+	}
+	return pb, notes
+}
+
+// pick returns the first of names present in hard.
+func pick(hard corev1.ResourceList, names ...corev1.ResourceName) (*resource.Quantity, corev1.ResourceName) {
+	for _, name := range names {
+		if q, ok := hard[name]; ok {
+			return &q, name
+		}
+	}
+	return nil, ""
+}
+
+// joinSources lists the source objects in a stable order.
+func joinSources(sources []string) string {
+	sorted := append([]string(nil), sources...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// newQuota returns a ResourceQuota with the given hard limits.
+func newQuota(name, ns string, hard map[corev1.ResourceName]string) corev1.ResourceQuota {
+	q := corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		Spec:       corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{}},
+	}
+	for k, v := range hard {
+		q.Spec.Hard[k] = resource.MustParse(v)
+	}
+	return q
+}
+
+var _ = Describe("Importer", func() {
+	It("should map limits to a DryRun budget and report the rest", func() {
+		quota := newQuota("compute", "team-a", map[corev1.ResourceName]string{
+			corev1.ResourceLimitsCPU:    "4",
+			corev1.ResourceLimitsMemory: "8Gi",
+			corev1.ResourcePods:         "20",
+		})
+		limitRange := corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "team-a"}}
+
+		results := Import([]corev1.ResourceQuota{quota}, []corev1.LimitRange{limitRange}, Options{BudgetNamespace: "finops"})
+		Expect(results).To(HaveLen(1))
+		pb := results[0].Budget
+		Expect(pb.Namespace).To(Equal("finops"))
+		Expect(pb.Spec).To(Equal(finopsv1.ProjectBudgetSpec{
+			TeamName:       "team-a",
+			MaxCpuLimit:    "4000m",
+			MaxMemoryLimit: "8192Mi",
+			ValidationMode: finopsv1.DryRunMode,
+		}))
+		Expect(results[0].Notes).To(ConsistOf(
+			"pods has no equivalent",
+			"LimitRange/defaults: per-container defaults and bounds have no equivalent",
+		))
+	})
+
+	It("should keep the tightest limit of several quotas", func() {
+		results := Import([]corev1.ResourceQuota{
+			newQuota("a", "team-a", map[corev1.ResourceName]string{corev1.ResourceLimitsCPU: "4"}),
+			newQuota("b", "team-a", map[corev1.ResourceName]string{corev1.ResourceLimitsCPU: "2500m"}),
+		}, nil, Options{})
+
+		Expect(results[0].Budget.Spec.MaxCpuLimit).To(Equal("2500m"))
+		Expect(results[0].Budget.Namespace).To(Equal("team-a"))
+		Expect(results[0].Budget.Annotations[ImportedFromAnnotation]).To(Equal("ResourceQuota/a,ResourceQuota/b"))
+	})
+
+	It("should fall back to requests and skip namespaces without a CPU quota", func() {
+		results := Import([]corev1.ResourceQuota{
+			newQuota("requests", "team-a", map[corev1.ResourceName]string{corev1.ResourceRequestsCPU: "2"}),
+			newQuota("pods", "team-b", map[corev1.ResourceName]string{corev1.ResourcePods: "10"}),
+		}, nil, Options{})
+
+		Expect(results[0].Budget.Spec.MaxCpuLimit).To(Equal("2000m"))
+		Expect(results[0].Notes).To(ContainElement(ContainSubstring("taken from requests.cpu")))
+		Expect(results[1].Budget).To(BeNil())
+		Expect(results[1].Notes).To(ContainElement(ContainSubstring("no budget generated")))
+	})
+
+	It("should decode lists and multi-document YAML and write the manifests", func() {
+		manifests := `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ResourceQuota
+  metadata: {name: compute, namespace: team-a}
+  spec:
+    hard: {limits.cpu: "1"}
+---
+apiVersion: v1
+kind: LimitRange
+metadata: {name: defaults, namespace: team-a}
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: ignored, namespace: team-a}
+`
+		quotas, limitRanges, err := Decode(strings.NewReader(manifests))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quotas).To(HaveLen(1))
+		Expect(limitRanges).To(HaveLen(1))
+
+		var out bytes.Buffer
+		Expect(WriteManifests(&out, Import(quotas, limitRanges, Options{}))).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("kind: ProjectBudget"))
+		Expect(out.String()).To(ContainSubstring("validationMode: DryRun"))
+	})
+})
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+var decoder = newDecoder()
+
+// newDecoder decodes core/v1 objects (including kubectl's List).
+func newDecoder() runtime.Decoder {
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		panic(err)
+	}
+	return serializer.NewCodecFactory(s).UniversalDeserializer()
+}
+
+// Decode reads the ResourceQuotas and LimitRanges of YAML or JSON manifests, such as the
+// output of "kubectl get resourcequotas,limitranges -A -o yaml". Other kinds are skipped.
+func Decode(r io.Reader) ([]corev1.ResourceQuota, []corev1.LimitRange, error) {
+	var quotas []corev1.ResourceQuota
+	var limitRanges []corev1.LimitRange
+
+	var collect func(raw []byte) error
+	collect = func(raw []byte) error {
+		obj, _, err := decoder.Decode(raw, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				return nil // Not a core/v1 object
+			}
+			return err
+		}
+		switch o := obj.(type) {
+		case *corev1.ResourceQuota:
+			quotas = append(quotas, *o)
+		case *corev1.LimitRange:
+			limitRanges = append(limitRanges, *o)
+		case *corev1.List:
+			for _, item := range o.Items {
+				if err := collect(item.Raw); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return quotas, limitRanges, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		if err := collect(doc); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// WriteManifests prints the generated budgets as a multi-document YAML stream.
+func WriteManifests(w io.Writer, results []Result) error {
+	for _, r := range results {
+		if r.Budget == nil {
+			continue
+		}
+		out, err := yaml.Marshal(r.Budget)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteReport prints, per namespace, what could not be carried over.
+func WriteReport(w io.Writer, results []Result) error {
+	for _, r := range results {
+		for _, note := range r.Notes {
+			if _, err := fmt.Fprintf(w, "%s: %s\n", r.Namespace, note); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
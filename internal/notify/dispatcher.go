@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// dispatcherQueueSize is the number of messages buffered while endpoints are slow or down.
+const dispatcherQueueSize = 100
+
+// dispatcherWorkers is the number of messages delivered at once, so one failing channel
+// retrying doesn't hold back the others.
+const dispatcherWorkers = 4
+
+// ErrQueueFull is returned when messages arrive faster than the channels take them (the message is dropped).
+var ErrQueueFull = errors.New("notification queue is full, message dropped")
+
+var dispatchLog = logf.Log.WithName("notify")
+
+// delivery is a message queued for a channel.
+type delivery struct {
+	channel *finopsv1.NotificationChannel
+	sender  Sender
+	msg     Message
+}
+
+// Dispatcher delivers messages in the background with retries and backoff, so slow endpoints
+// never delay reconciliation. It is a manager Runnable: queued messages are delivered once started.
+type Dispatcher struct {
+	// Reader reads the Secrets of the channels (e.g., the API reader of the manager, so Secrets aren't cached)
+	Reader client.Reader
+	// Recorder reports the deliveries that failed after retrying as events on the channel. Optional.
+	Recorder record.EventRecorder
+	// HTTPClient posts Webhook and Slack notifications
+	HTTPClient *http.Client
+	// Backoff spaces the attempts of each delivery
+	Backoff wait.Backoff
+
+	queue chan delivery
+}
+
+// NewDispatcher returns a dispatcher with a 10s timeout HTTP client and DefaultBackoff.
+func NewDispatcher(reader client.Reader, recorder record.EventRecorder) *Dispatcher {
+	return &Dispatcher{
+		Reader:     reader,
+		Recorder:   recorder,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		Backoff:    DefaultBackoff,
+		queue:      make(chan delivery, dispatcherQueueSize),
+	}
+}
+
+// Notify resolves the sender of channel and queues msg for it without waiting for the endpoint.
+// Invalid channels (e.g., a missing Secret) are reported right away.
+func (d *Dispatcher) Notify(ctx context.Context, channel *finopsv1.NotificationChannel, msg Message) error {
+	sender, err := NewSender(ctx, d.Reader, channel, d.HTTPClient)
+	if err != nil {
+		return err
+	}
+	select {
+	case d.queue <- delivery{channel: channel.DeepCopy(), sender: sender, msg: msg}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Start delivers the queued messages until ctx is done.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for i := 0; i < dispatcherWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case next := <-d.queue:
+					d.deliver(ctx, next)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// deliver sends one message, reporting it on the channel when every attempt failed.
+func (d *Dispatcher) deliver(ctx context.Context, next delivery) {
+	err := Deliver(ctx, next.sender, next.msg, d.Backoff)
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+	dispatchLog.Error(err, "Failed to deliver notification", "channel", next.channel.Name,
+		"namespace", next.channel.Namespace, "event", next.msg.Event, "budget", next.msg.Budget)
+	if d.Recorder != nil {
+		d.Recorder.Eventf(next.channel, corev1.EventTypeWarning, "DeliveryFailed",
+			"Failed to deliver the %s notification of budget %s: %v", next.msg.Event, next.msg.Budget, err)
+	}
+}
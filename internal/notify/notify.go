@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify delivers budget notifications (threshold crossings, denied pods, enforcement
+// actions) to the endpoints of NotificationChannels: generic webhooks, Slack and SMTP.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// Message is one notification about a budget.
+type Message struct {
+	Event finopsv1.NotificationEvent `json:"event"`
+	Time  time.Time                  `json:"time"`
+	// Budget is the name of the ProjectBudget and Namespace where it lives
+	Budget    string `json:"budget"`
+	Namespace string `json:"namespace"`
+	// Team is the namespace governed by the budget
+	Team string `json:"team"`
+	// Summary is a one-line description (e.g., "team-beta reached 80% of its CPU budget")
+	Summary string `json:"summary"`
+	// Details are additional lines (e.g., the figures or the pods denied)
+	Details []string `json:"details,omitempty"`
+}
+
+// Subject is the title of the message (e.g., the email subject).
+func (m Message) Subject() string {
+	return fmt.Sprintf("[FinOps] %s: %s", m.Event, m.Summary)
+}
+
+// Text renders the message for humans: the summary followed by one bullet per detail.
+func (m Message) Text() string {
+	var b strings.Builder
+	b.WriteString(m.Summary)
+	for _, d := range m.Details {
+		b.WriteString("\n• ")
+		b.WriteString(d)
+	}
+	return b.String()
+}
+
+// Sender delivers a message once. Errors wrapped with Permanent are not retried.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// permanentError is a failure that retrying won't fix (e.g., a 404 or a rejected recipient).
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not worth retrying.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// DefaultBackoff retries for about half a minute: 1s, 2s, 4s, 8s and 16s (with jitter) between five attempts.
+var DefaultBackoff = wait.Backoff{Duration: time.Second, Factor: 2, Jitter: 0.1, Steps: 5}
+
+// Deliver sends msg, retrying transient failures with backoff until it succeeds, a permanent
+// error is returned, the attempts run out or ctx is done. It returns the last error.
+func Deliver(ctx context.Context, s Sender, msg Message, backoff wait.Backoff) error {
+	var lastErr error
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		lastErr = s.Send(ctx, msg)
+		var permanent *permanentError
+		if errors.As(lastErr, &permanent) {
+			return false, lastErr
+		}
+		return lastErr == nil, nil
+	})
+	if err != nil && wait.Interrupted(err) && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
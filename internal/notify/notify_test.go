@@ -0,0 +1,212 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+var _ = Describe("Notifications", func() {
+	ctx := context.Background()
+	fastBackoff := wait.Backoff{Duration: time.Millisecond, Factor: 2, Steps: 4}
+	msg := Message{
+		Event:     finopsv1.NotifyThresholdCrossed,
+		Time:      time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC),
+		Budget:    "team-a-budget",
+		Namespace: "default",
+		Team:      "team-a",
+		Summary:   "Namespace team-a reached 85% of its CPU budget (threshold 80%)",
+		Details:   []string{"CPU: 1700m of 2"},
+	}
+
+	// endpoint answers with the given status codes in turn (200 once they run out) and keeps the bodies
+	endpoint := func(codes ...int) (*httptest.Server, func() []string) {
+		var mu sync.Mutex
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			defer mu.Unlock()
+			bodies = append(bodies, string(body))
+			if len(bodies) <= len(codes) {
+				w.WriteHeader(codes[len(bodies)-1])
+			}
+		}))
+		DeferCleanup(server.Close)
+		return server, func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]string(nil), bodies...)
+		}
+	}
+
+	It("should POST the message as JSON to generic webhooks", func() {
+		server, bodies := endpoint()
+		Expect(Deliver(ctx, &WebhookSender{URL: server.URL}, msg, fastBackoff)).To(Succeed())
+
+		Expect(bodies()).To(HaveLen(1))
+		var decoded map[string]any
+		Expect(json.Unmarshal([]byte(bodies()[0]), &decoded)).To(Succeed())
+		Expect(decoded).To(HaveKeyWithValue("event", "ThresholdCrossed"))
+		Expect(decoded).To(HaveKeyWithValue("budget", "team-a-budget"))
+		Expect(decoded).To(HaveKeyWithValue("team", "team-a"))
+		Expect(decoded).To(HaveKeyWithValue("details", []any{"CPU: 1700m of 2"}))
+	})
+
+	It("should POST a Slack-compatible payload to Slack channels", func() {
+		server, bodies := endpoint()
+		Expect(Deliver(ctx, &SlackSender{URL: server.URL}, msg, fastBackoff)).To(Succeed())
+
+		var payload map[string]string
+		Expect(json.Unmarshal([]byte(bodies()[0]), &payload)).To(Succeed())
+		Expect(payload).To(Equal(map[string]string{
+			"text": "*ThresholdCrossed* Namespace team-a reached 85% of its CPU budget (threshold 80%)\n• CPU: 1700m of 2",
+		}))
+	})
+
+	It("should retry server errors with backoff until the endpoint accepts the message", func() {
+		server, bodies := endpoint(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		Expect(Deliver(ctx, &WebhookSender{URL: server.URL}, msg, fastBackoff)).To(Succeed())
+		Expect(bodies()).To(HaveLen(3))
+	})
+
+	It("should give up on client errors and after the last attempt", func() {
+		rejecting, rejected := endpoint(http.StatusNotFound)
+		Expect(Deliver(ctx, &WebhookSender{URL: rejecting.URL}, msg, fastBackoff)).
+			To(MatchError(ContainSubstring("404")))
+		Expect(rejected()).To(HaveLen(1))
+
+		down, attempts := endpoint(500, 500, 500, 500, 500)
+		Expect(Deliver(ctx, &WebhookSender{URL: down.URL}, msg, fastBackoff)).
+			To(MatchError(ContainSubstring("500")))
+		Expect(attempts()).To(HaveLen(4))
+	})
+
+	It("should build an email with the summary as subject", func() {
+		sender := &SMTPSender{From: "finops@example.com", To: []string{"team-a@example.com", "oncall@example.com"}}
+		mail := string(sender.message(msg))
+
+		Expect(mail).To(ContainSubstring("To: team-a@example.com, oncall@example.com\r\n"))
+		Expect(mail).To(ContainSubstring("Subject: [FinOps] ThresholdCrossed: Namespace team-a reached 85%"))
+		Expect(mail).To(ContainSubstring("\r\n\r\nNamespace team-a reached 85% of its CPU budget (threshold 80%)\r\n• CPU: 1700m of 2"))
+		Expect(mail).To(HaveSuffix("Budget: default/team-a-budget\r\n"))
+	})
+
+	Context("When dispatching to channels", func() {
+		newReader := func(objs ...client.Object) client.Client {
+			s := runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(s)).To(Succeed())
+			Expect(finopsv1.AddToScheme(s)).To(Succeed())
+			return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+		}
+
+		run := func(d *Dispatcher) {
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_ = d.Start(runCtx)
+			}()
+			DeferCleanup(func() {
+				cancel()
+				<-done
+			})
+		}
+
+		It("should read the URL of a channel from its Secret and deliver in the background", func() {
+			server, bodies := endpoint()
+			reader := newReader(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "finops-slack", Namespace: "default"},
+				Data:       map[string][]byte{"url": []byte(server.URL + "\n")},
+			})
+			d := NewDispatcher(reader, nil)
+			d.Backoff = fastBackoff
+			run(d)
+
+			channel := &finopsv1.NotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "slack", Namespace: "default"},
+				Spec: finopsv1.NotificationChannelSpec{
+					Type:    finopsv1.ChannelSlack,
+					Webhook: &finopsv1.WebhookTarget{URLSecretRef: &finopsv1.SecretKeyRef{Name: "finops-slack", Key: "url"}},
+				},
+			}
+			Expect(d.Notify(ctx, channel, msg)).To(Succeed())
+			Eventually(bodies).Should(HaveLen(1))
+			Expect(bodies()[0]).To(HavePrefix(`{"text":"*ThresholdCrossed*`))
+		})
+
+		It("should reject channels whose Secret is missing right away", func() {
+			d := NewDispatcher(newReader(), nil)
+			channel := &finopsv1.NotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "hook", Namespace: "default"},
+				Spec: finopsv1.NotificationChannelSpec{
+					Type:    finopsv1.ChannelWebhook,
+					Webhook: &finopsv1.WebhookTarget{URLSecretRef: &finopsv1.SecretKeyRef{Name: "missing", Key: "url"}},
+				},
+			}
+			Expect(d.Notify(ctx, channel, msg)).To(MatchError(ContainSubstring("reading the URL of channel hook")))
+		})
+
+		It("should report deliveries that failed after retrying on the channel", func() {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			DeferCleanup(server.Close)
+			recorder := record.NewFakeRecorder(10)
+			d := NewDispatcher(newReader(), recorder)
+			d.Backoff = fastBackoff
+			run(d)
+
+			channel := &finopsv1.NotificationChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "hook", Namespace: "default"},
+				Spec: finopsv1.NotificationChannelSpec{
+					Type:    finopsv1.ChannelWebhook,
+					Webhook: &finopsv1.WebhookTarget{URL: server.URL},
+				},
+			}
+			Expect(d.Notify(ctx, channel, msg)).To(Succeed())
+
+			var event string
+			Eventually(recorder.Events).Should(Receive(&event))
+			Expect(event).To(HavePrefix("Warning DeliveryFailed Failed to deliver the ThresholdCrossed notification of budget team-a-budget"))
+			Expect(event).To(ContainSubstring("502"))
+			Expect(attempts.Load()).To(Equal(int32(4)))
+		})
+	})
+})
@@ -0,0 +1,194 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// WebhookSender POSTs the message as JSON.
+type WebhookSender struct {
+	URL    string
+	Client *http.Client
+}
+
+// Send POSTs msg to the endpoint.
+func (s *WebhookSender) Send(ctx context.Context, msg Message) error {
+	return postJSON(ctx, s.Client, s.URL, msg)
+}
+
+// SlackSender POSTs the message as a Slack incoming-webhook payload.
+type SlackSender struct {
+	URL    string
+	Client *http.Client
+}
+
+// slackPayload is the minimal body accepted by Slack incoming webhooks (and compatible chat tools).
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// Send POSTs msg to the incoming webhook, with the summary in bold.
+func (s *SlackSender) Send(ctx context.Context, msg Message) error {
+	text := fmt.Sprintf("*%s* %s", msg.Event, msg.Text())
+	return postJSON(ctx, s.Client, s.URL, slackPayload{Text: text})
+}
+
+// postJSON POSTs body as JSON. Server errors and throttling are retried, other rejections are permanent.
+func postJSON(ctx context.Context, c *http.Client, url string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return Permanent(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return Permanent(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c == nil {
+		c = http.DefaultClient
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("notification endpoint returned %s", resp.Status)
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+		return err
+	}
+	return Permanent(err)
+}
+
+// SMTPSender emails the message to the recipients.
+type SMTPSender struct {
+	// Addr is the host:port of the mail server
+	Addr string
+	From string
+	To   []string
+	// Auth is nil to send without authentication
+	Auth smtp.Auth
+}
+
+// Send emails msg. Replies with a 5xx code (e.g., unknown recipient) are permanent.
+func (s *SMTPSender) Send(_ context.Context, msg Message) error {
+	err := smtp.SendMail(s.Addr, s.Auth, s.From, s.To, s.message(msg))
+	var reply *textproto.Error
+	if errors.As(err, &reply) && reply.Code >= 500 {
+		return Permanent(err)
+	}
+	return err
+}
+
+// message builds a plain-text email with msg.
+func (s *SMTPSender) message(msg Message) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", s.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(s.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject())
+	fmt.Fprintf(&b, "Date: %s\r\n", msg.Time.Format("Mon, 02 Jan 2006 15:04:05 -0700"))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Text(), "\n", "\r\n"))
+	fmt.Fprintf(&b, "\r\n\r\nBudget: %s/%s\r\n", msg.Namespace, msg.Budget)
+	return b.Bytes()
+}
+
+// NewSender builds the sender of a channel, reading its credentials with reader from Secrets
+// in the namespace of the channel.
+func NewSender(ctx context.Context, reader client.Reader, channel *finopsv1.NotificationChannel, httpClient *http.Client) (Sender, error) {
+	spec := &channel.Spec
+	switch spec.Type {
+	case finopsv1.ChannelWebhook, finopsv1.ChannelSlack:
+		url, err := webhookURL(ctx, reader, channel)
+		if err != nil {
+			return nil, err
+		}
+		if spec.Type == finopsv1.ChannelSlack {
+			return &SlackSender{URL: url, Client: httpClient}, nil
+		}
+		return &WebhookSender{URL: url, Client: httpClient}, nil
+	case finopsv1.ChannelSMTP:
+		if spec.SMTP == nil {
+			return nil, fmt.Errorf("channel %s of type SMTP has no smtp settings", channel.Name)
+		}
+		port := spec.SMTP.Port
+		if port == 0 {
+			port = 587
+		}
+		sender := &SMTPSender{
+			Addr: net.JoinHostPort(spec.SMTP.Host, strconv.Itoa(int(port))),
+			From: spec.SMTP.From,
+			To:   spec.SMTP.To,
+		}
+		if spec.SMTP.CredentialsSecretRef != "" {
+			var secret corev1.Secret
+			key := types.NamespacedName{Name: spec.SMTP.CredentialsSecretRef, Namespace: channel.Namespace}
+			if err := reader.Get(ctx, key, &secret); err != nil {
+				return nil, fmt.Errorf("reading SMTP credentials of channel %s: %w", channel.Name, err)
+			}
+			sender.Auth = smtp.PlainAuth("", string(secret.Data["username"]), string(secret.Data["password"]), spec.SMTP.Host)
+		}
+		return sender, nil
+	default:
+		return nil, fmt.Errorf("channel %s has unknown type %q", channel.Name, spec.Type)
+	}
+}
+
+// webhookURL returns the URL of a Webhook or Slack channel, read from its Secret when referenced.
+func webhookURL(ctx context.Context, reader client.Reader, channel *finopsv1.NotificationChannel) (string, error) {
+	target := channel.Spec.Webhook
+	if target == nil {
+		return "", fmt.Errorf("channel %s of type %s has no webhook settings", channel.Name, channel.Spec.Type)
+	}
+	if target.URLSecretRef == nil {
+		if target.URL == "" {
+			return "", fmt.Errorf("channel %s has neither a url nor a urlSecretRef", channel.Name)
+		}
+		return target.URL, nil
+	}
+
+	var secret corev1.Secret
+	key := types.NamespacedName{Name: target.URLSecretRef.Name, Namespace: channel.Namespace}
+	if err := reader.Get(ctx, key, &secret); err != nil {
+		return "", fmt.Errorf("reading the URL of channel %s: %w", channel.Name, err)
+	}
+	url := strings.TrimSpace(string(secret.Data[target.URLSecretRef.Key]))
+	if url == "" {
+		return "", fmt.Errorf("key %q of Secret %s is empty", target.URLSecretRef.Key, target.URLSecretRef.Name)
+	}
+	return url, nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format is an output format of a report.
+type Format string
+
+const (
+	// FormatCSV renders one line per budget
+	FormatCSV Format = "csv"
+	// FormatJSON renders the whole report
+	FormatJSON Format = "json"
+	// FormatMarkdown renders the group totals and the budgets as tables
+	FormatMarkdown Format = "markdown"
+)
+
+// Formats lists the supported formats.
+var Formats = []Format{FormatCSV, FormatJSON, FormatMarkdown}
+
+// Write renders r in the given format.
+func Write(w io.Writer, format Format, r *Showback) error {
+	switch format {
+	case FormatCSV:
+		return WriteCSV(w, r)
+	case FormatJSON:
+		return WriteJSON(w, r)
+	case FormatMarkdown:
+		return WriteMarkdown(w, r)
+	}
+	return fmt.Errorf("unknown report format %q (expected csv, json or markdown)", format)
+}
+
+// WriteCSV renders one line per budget, with a column per groupBy label.
+func WriteCSV(w io.Writer, r *Showback) error {
+	out := csv.NewWriter(w)
+	header := append([]string{"budget", "namespace"}, r.GroupBy...)
+	header = append(header, "cpu_core_hours", "memory_gib_hours", "cost", "currency", "partial")
+	if err := out.Write(header); err != nil {
+		return err
+	}
+	for _, b := range r.Budgets {
+		record := []string{b.Budget, b.Namespace}
+		for _, key := range r.GroupBy {
+			record = append(record, LabelValue(b.Labels, key))
+		}
+		record = append(record, b.CpuCoreHours, b.MemoryGiBHours, b.Cost, b.Currency, strconv.FormatBool(b.Partial))
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// WriteJSON renders the whole report.
+func WriteJSON(w io.Writer, r *Showback) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteMarkdown renders the group totals and the budgets as tables. Budgets whose recorded
+// history doesn't cover the whole range are flagged with a footnote.
+func WriteMarkdown(w io.Writer, r *Showback) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Budget report\n\nFrom %s to %s.\n\n", r.Start.UTC().Format(time.RFC3339), r.End.UTC().Format(time.RFC3339))
+
+	if len(r.GroupBy) > 0 {
+		fmt.Fprintf(&b, "## By %s\n\n", strings.Join(r.GroupBy, ", "))
+	} else {
+		b.WriteString("## Total\n\n")
+	}
+	writeRow(&b, append(append([]string{}, r.GroupBy...), "Budgets", "CPU core-hours", "Memory GiB-hours", "Cost"))
+	writeAlignment(&b, len(r.GroupBy)+1, 3)
+	for _, g := range r.Groups {
+		var row []string
+		for _, key := range r.GroupBy {
+			row = append(row, LabelValue(g.Labels, key))
+		}
+		writeRow(&b, append(row, strconv.Itoa(int(g.Budgets)), g.CpuCoreHours, g.MemoryGiBHours, amount(g.Cost, g.Currency)))
+	}
+
+	b.WriteString("\n## By budget\n\n")
+	writeRow(&b, append(append([]string{"Budget", "Namespace"}, r.GroupBy...), "CPU core-hours", "Memory GiB-hours", "Cost"))
+	writeAlignment(&b, len(r.GroupBy)+2, 3)
+	partial := false
+	for _, u := range r.Budgets {
+		name := u.Budget
+		if u.Partial {
+			name += " *"
+			partial = true
+		}
+		row := []string{name, u.Namespace}
+		for _, key := range r.GroupBy {
+			row = append(row, LabelValue(u.Labels, key))
+		}
+		writeRow(&b, append(row, u.CpuCoreHours, u.MemoryGiBHours, amount(u.Cost, u.Currency)))
+	}
+	if partial {
+		b.WriteString("\n\\* The recorded consumption of the budget starts after the beginning of the range.\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeRow writes one line of a Markdown table.
+func writeRow(b *strings.Builder, cells []string) {
+	fmt.Fprintf(b, "| %s |\n", strings.Join(cells, " | "))
+}
+
+// writeAlignment writes the separator line of a Markdown table: left-aligned text columns
+// followed by right-aligned figures.
+func writeAlignment(b *strings.Builder, text, figures int) {
+	cells := make([]string, 0, text+figures)
+	for range text {
+		cells = append(cells, "---")
+	}
+	for range figures {
+		cells = append(cells, "---:")
+	}
+	writeRow(b, cells)
+}
+
+// amount renders a cost with its currency, or "-" when it could not be priced.
+func amount(cost, currency string) string {
+	if cost == "" {
+		return "-"
+	}
+	return strings.TrimSpace(cost + " " + currency)
+}
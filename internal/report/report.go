@@ -0,0 +1,240 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package report aggregates the consumption recorded by ProjectBudgets over a time range into
+// showback reports, grouped by labels of the team namespaces (e.g., cost-center), and renders
+// them as CSV, JSON or Markdown.
+package report
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/cost"
+)
+
+// NoLabel stands for a groupBy label missing on a team namespace.
+const NoLabel = "(none)"
+
+// Budget is a ProjectBudget with what the report needs around it.
+type Budget struct {
+	finopsv1.ProjectBudget
+	// NamespaceLabels are the labels of the team namespace
+	NamespaceLabels map[string]string
+	// Model prices the consumption (nil leaves the cost empty)
+	Model *cost.Model
+}
+
+// Options select the time range and grouping of a report.
+type Options struct {
+	Start time.Time
+	End   time.Time
+	// GroupBy are the namespace label keys the totals are grouped by
+	GroupBy []string
+	// Now is when the report is computed: usage is accrued up to it
+	Now time.Time
+}
+
+// Showback is the consumption of each budget over a time range, and the totals per group.
+type Showback struct {
+	Start   time.Time              `json:"start"`
+	End     time.Time              `json:"end"`
+	GroupBy []string               `json:"groupBy,omitempty"`
+	Budgets []finopsv1.BudgetUsage `json:"budgets"`
+	Groups  []finopsv1.GroupUsage  `json:"groups"`
+}
+
+// MonthStart returns the first instant of the month containing t (UTC), the default start of a report.
+func MonthStart(t time.Time) time.Time {
+	return budget.PeriodStart(finopsv1.BillingPeriodMonthly, t)
+}
+
+// Load lists the ProjectBudgets of a namespace ("" for all) matching selector, with the labels
+// of their team namespace and their CostModel. A missing namespace or CostModel leaves the
+// labels or the cost empty.
+func Load(ctx context.Context, c client.Reader, namespace string, selector labels.Selector) ([]Budget, error) {
+	var list finopsv1.ProjectBudgetList
+	if err := c.List(ctx, &list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	models := map[string]*cost.Model{}
+	budgets := make([]Budget, 0, len(list.Items))
+	for _, pb := range list.Items {
+		b := Budget{ProjectBudget: pb}
+
+		var ns corev1.Namespace
+		if err := c.Get(ctx, types.NamespacedName{Name: pb.Spec.TeamName}, &ns); err == nil {
+			b.NamespaceLabels = ns.Labels
+		} else if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		name := pb.Spec.CostModelRef
+		if name == "" {
+			name = finopsv1.DefaultCostModelName
+		}
+		model, cached := models[name]
+		if !cached {
+			var err error
+			if model, err = cost.Lookup(ctx, c, &pb.Spec); err != nil && !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+			models[name] = model
+		}
+		b.Model = model
+		budgets = append(budgets, b)
+	}
+	return budgets, nil
+}
+
+// groupTotals accumulates the budgets of a group.
+type groupTotals struct {
+	labels   map[string]string
+	budgets  int32
+	consumed budget.Consumption
+	cost     float64
+	currency string
+	// priced is false once a budget of the group has no cost, or a cost in another currency
+	priced bool
+}
+
+// Build computes the consumption of each budget between opts.Start and opts.End, sorted by
+// namespace, and the totals of the budgets sharing the same opts.GroupBy label values (a
+// single total when there are no groupBy labels).
+func Build(budgets []Budget, opts Options) (*Showback, error) {
+	report := &Showback{Start: opts.Start, End: opts.End, GroupBy: opts.GroupBy}
+	groups := map[string]*groupTotals{}
+
+	for _, b := range budgets {
+		consumed, since, err := budget.ConsumedBetween(&b.Status, opts.Start, opts.End, opts.Now)
+		if err != nil {
+			return nil, err
+		}
+
+		usage := finopsv1.BudgetUsage{
+			Budget:         b.Name,
+			Namespace:      b.Spec.TeamName,
+			Labels:         groupLabels(b.NamespaceLabels, opts.GroupBy),
+			CpuCoreHours:   budget.FormatHours(consumed.CPUCoreHours),
+			MemoryGiBHours: budget.FormatHours(consumed.MemoryGiBHours),
+			Partial:        since.IsZero() || since.After(opts.Start),
+		}
+		var amount float64
+		if b.Model != nil {
+			amount = consumed.CPUCoreHours*b.Model.CPUHour + consumed.MemoryGiBHours*b.Model.MemoryGiBHour
+			usage.Cost = cost.FormatAmount(amount)
+			usage.Currency = b.Model.Currency
+		}
+		report.Budgets = append(report.Budgets, usage)
+
+		key := groupKey(usage.Labels, opts.GroupBy)
+		g, ok := groups[key]
+		if !ok {
+			g = &groupTotals{labels: usage.Labels, currency: usage.Currency, priced: true}
+			groups[key] = g
+		}
+		g.budgets++
+		g.consumed.CPUCoreHours += consumed.CPUCoreHours
+		g.consumed.MemoryGiBHours += consumed.MemoryGiBHours
+		g.cost += amount
+		g.priced = g.priced && b.Model != nil && usage.Currency == g.currency
+	}
+
+	sort.Slice(report.Budgets, func(i, j int) bool {
+		a, b := report.Budgets[i], report.Budgets[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Budget < b.Budget
+	})
+
+	// Groups missing a label come after the labelled ones
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := strings.Split(keys[i], "\x00"), strings.Split(keys[j], "\x00")
+		for k := range a {
+			if a[k] == b[k] {
+				continue
+			}
+			if a[k] == NoLabel || b[k] == NoLabel {
+				return b[k] == NoLabel
+			}
+			return a[k] < b[k]
+		}
+		return false
+	})
+	report.Groups = make([]finopsv1.GroupUsage, 0, len(keys))
+	for _, key := range keys {
+		g := groups[key]
+		usage := finopsv1.GroupUsage{
+			Labels:         g.labels,
+			Budgets:        g.budgets,
+			CpuCoreHours:   budget.FormatHours(g.consumed.CPUCoreHours),
+			MemoryGiBHours: budget.FormatHours(g.consumed.MemoryGiBHours),
+		}
+		if g.priced {
+			usage.Cost = cost.FormatAmount(g.cost)
+			usage.Currency = g.currency
+		}
+		report.Groups = append(report.Groups, usage)
+	}
+	return report, nil
+}
+
+// groupLabels keeps the groupBy labels present on the namespace.
+func groupLabels(namespaceLabels map[string]string, groupBy []string) map[string]string {
+	var out map[string]string
+	for _, key := range groupBy {
+		if value, ok := namespaceLabels[key]; ok {
+			if out == nil {
+				out = map[string]string{}
+			}
+			out[key] = value
+		}
+	}
+	return out
+}
+
+// groupKey identifies the group of the given label values.
+func groupKey(groupLabels map[string]string, groupBy []string) string {
+	values := make([]string, len(groupBy))
+	for i, key := range groupBy {
+		values[i] = LabelValue(groupLabels, key)
+	}
+	return strings.Join(values, "\x00")
+}
+
+// LabelValue returns the value of a groupBy label, or NoLabel when the namespace doesn't have it.
+func LabelValue(groupLabels map[string]string, key string) string {
+	if value, ok := groupLabels[key]; ok {
+		return value
+	}
+	return NoLabel
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/cost"
+)
+
+var _ = Describe("Report", func() {
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	model := &cost.Model{Currency: "USD", CPUHour: 0.05, MemoryGiBHour: 0.01}
+
+	// newBudget returns a budget that consumed 80 core-hours and 160 GiB-hours since March 1st.
+	newBudget := func(name, team string) finopsv1.ProjectBudget {
+		return finopsv1.ProjectBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "finops"},
+			Spec:       finopsv1.ProjectBudgetSpec{TeamName: team},
+			Status: finopsv1.ProjectBudgetStatus{Consumption: &finopsv1.ConsumptionStatus{
+				PeriodStart:    metav1.Time{Time: march},
+				CpuCoreHours:   "80.000",
+				MemoryGiBHours: "160.000",
+				LastSampleTime: metav1.Time{Time: now},
+			}},
+		}
+	}
+
+	build := func() *Showback {
+		unrecorded := newBudget("team-beta-budget", "team-beta")
+		unrecorded.Status.Consumption = nil
+		r, err := Build([]Budget{
+			{ProjectBudget: newBudget("team-gamma-budget", "team-gamma"), NamespaceLabels: map[string]string{"cost-center": "1001"}},
+			{ProjectBudget: unrecorded, Model: model},
+			{ProjectBudget: newBudget("team-alpha-budget", "team-alpha"), NamespaceLabels: map[string]string{"cost-center": "1001"}, Model: model},
+		}, Options{Start: march, End: march.AddDate(0, 1, 0), GroupBy: []string{"cost-center"}, Now: now})
+		Expect(err).NotTo(HaveOccurred())
+		return r
+	}
+
+	It("should price each budget and total the budgets by namespace label", func() {
+		r := build()
+
+		Expect(r.Budgets).To(Equal([]finopsv1.BudgetUsage{
+			{Budget: "team-alpha-budget", Namespace: "team-alpha", Labels: map[string]string{"cost-center": "1001"},
+				CpuCoreHours: "80.000", MemoryGiBHours: "160.000", Cost: "5.60", Currency: "USD"},
+			{Budget: "team-beta-budget", Namespace: "team-beta",
+				CpuCoreHours: "0.000", MemoryGiBHours: "0.000", Cost: "0.00", Currency: "USD", Partial: true},
+			{Budget: "team-gamma-budget", Namespace: "team-gamma", Labels: map[string]string{"cost-center": "1001"},
+				CpuCoreHours: "80.000", MemoryGiBHours: "160.000"},
+		}))
+
+		By("leaving the cost of a group empty when one of its budgets is not priced")
+		Expect(r.Groups).To(Equal([]finopsv1.GroupUsage{
+			{Labels: map[string]string{"cost-center": "1001"}, Budgets: 2, CpuCoreHours: "160.000", MemoryGiBHours: "320.000"},
+			{Budgets: 1, CpuCoreHours: "0.000", MemoryGiBHours: "0.000", Cost: "0.00", Currency: "USD"},
+		}))
+	})
+
+	It("should render CSV and Markdown", func() {
+		r := build()
+
+		var out bytes.Buffer
+		Expect(Write(&out, FormatCSV, r)).To(Succeed())
+		Expect(out.String()).To(Equal("budget,namespace,cost-center,cpu_core_hours,memory_gib_hours,cost,currency,partial\n" +
+			"team-alpha-budget,team-alpha,1001,80.000,160.000,5.60,USD,false\n" +
+			"team-beta-budget,team-beta,(none),0.000,0.000,0.00,USD,true\n" +
+			"team-gamma-budget,team-gamma,1001,80.000,160.000,,,false\n"))
+
+		out.Reset()
+		Expect(Write(&out, FormatMarkdown, r)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("From 2026-03-01T00:00:00Z to 2026-04-01T00:00:00Z."))
+		Expect(out.String()).To(ContainSubstring("## By cost-center\n\n" +
+			"| cost-center | Budgets | CPU core-hours | Memory GiB-hours | Cost |\n" +
+			"| --- | --- | ---: | ---: | ---: |\n" +
+			"| 1001 | 2 | 160.000 | 320.000 | - |\n" +
+			"| (none) | 1 | 0.000 | 0.000 | 0.00 USD |\n"))
+		Expect(out.String()).To(ContainSubstring("| team-beta-budget * | team-beta | (none) | 0.000 | 0.000 | 0.00 USD |\n"))
+		Expect(out.String()).To(HaveSuffix("\\* The recorded consumption of the budget starts after the beginning of the range.\n"))
+
+		Expect(Write(&out, "xml", r)).To(MatchError(ContainSubstring("unknown report format")))
+	})
+
+	It("should load the budgets with their namespace labels and cost model", func() {
+		s := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(s)).To(Succeed())
+		Expect(finopsv1.AddToScheme(s)).To(Succeed())
+
+		alpha, beta := newBudget("team-alpha-budget", "team-alpha"), newBudget("team-beta-budget", "team-beta")
+		beta.Spec.CostModelRef = "missing"
+		objs := []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-alpha", Labels: map[string]string{"cost-center": "1001"}}},
+			&finopsv1.CostModel{
+				ObjectMeta: metav1.ObjectMeta{Name: finopsv1.DefaultCostModelName},
+				Spec:       finopsv1.CostModelSpec{Currency: "EUR", CpuHourPrice: "0.05", MemoryGiBHourPrice: "0.01"},
+			},
+			&alpha, &beta,
+		}
+		c := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+
+		budgets, err := Load(context.Background(), c, "finops", labels.Everything())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(budgets).To(HaveLen(2))
+		Expect(budgets[0].Name).To(Equal("team-alpha-budget"))
+		Expect(budgets[0].NamespaceLabels).To(HaveKeyWithValue("cost-center", "1001"))
+		Expect(budgets[0].Model.Currency).To(Equal("EUR"))
+		Expect(budgets[1].NamespaceLabels).To(BeEmpty())
+		Expect(budgets[1].Model).To(BeNil())
+	})
+})
@@ -0,0 +1,105 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing sets up OpenTelemetry tracing for the admission webhooks and controllers.
+// Spans are no-ops unless an OTLP endpoint is configured.
+package tracing
+
+import (
+	"context"
+	"flag"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of the operator spans.
+const ScopeName = "github.com/AlejandroCasa/k8s-governance-operator"
+
+// Span attributes shared by the webhooks and controllers
+const (
+	// NamespaceKey is the namespace of the pod or of the budget
+	NamespaceKey = attribute.Key("finops.namespace")
+	// BudgetKey is the name of the budget checked or reconciled
+	BudgetKey = attribute.Key("finops.budget")
+	// DecisionKey is the outcome of an admission (allowed, denied, dry_run, exempt, error_fail_open)
+	DecisionKey = attribute.Key("finops.decision")
+)
+
+// Options configures the OTLP trace exporter.
+type Options struct {
+	// Endpoint is the host:port of the OTLP/gRPC collector. Tracing is disabled when empty.
+	Endpoint string
+	// Insecure disables TLS towards the collector
+	Insecure bool
+	// SampleRatio is the fraction of new traces recorded (parent decisions are honoured)
+	SampleRatio float64
+	// ServiceName identifies the operator in the traces
+	ServiceName string
+}
+
+// BindFlags registers the tracing flags on fs.
+func (o *Options) BindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Endpoint, "otlp-endpoint", "",
+		"OTLP/gRPC collector (host:port) receiving the traces. Tracing is disabled if empty.")
+	fs.BoolVar(&o.Insecure, "otlp-insecure", false, "If set, traces are sent to the collector without TLS.")
+	fs.Float64Var(&o.SampleRatio, "trace-sample-ratio", 1, "Fraction of admissions and reconciliations traced (0 to 1).")
+	fs.StringVar(&o.ServiceName, "trace-service-name", "k8s-governance-operator", "Service name reported in the traces.")
+}
+
+// Setup installs the global tracer provider exporting to the configured collector and returns
+// the function flushing the pending spans on exit. Without an endpoint nothing is installed.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if opts.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+	if opts.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(),
+		resource.NewSchemaless(attribute.String("service.name", opts.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the operator tracer of tp, or of the global provider when tp is nil.
+func Tracer(tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return tp.Tracer(ScopeName)
+}
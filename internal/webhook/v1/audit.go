@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/audit"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// decisionResized is the audit decision of a pod shrunk by the mutating webhook
+const decisionResized = "resized"
+
+// auditRecord starts the audit record of a decision about pod: who created it and what it reserves.
+func (v *PodCustomValidator) auditRecord(ctx context.Context, pod *corev1.Pod, decision string) audit.Record {
+	rec := audit.Record{
+		Time:      v.now(),
+		Decision:  decision,
+		Namespace: pod.Namespace,
+		Pod:       podName(pod),
+		Request:   audit.Figures(budget.PodResources(pod)),
+	}
+	if req, err := admission.RequestFromContext(ctx); err == nil {
+		rec.User = req.UserInfo.Username
+	}
+	return rec
+}
+
+// auditDecision writes the outcome of a pod validation with what the admission ran into.
+func (v *PodCustomValidator) auditDecision(ctx context.Context, pod *corev1.Pod, outcome string, d *admissionDecision) {
+	if v.Audit == nil {
+		return
+	}
+	rec := v.auditRecord(ctx, pod, outcome)
+	rec.Budget = d.budgetName
+	if d.used != nil {
+		used := audit.Figures(*d.used)
+		rec.Used = &used
+	}
+	if d.limit != nil {
+		limit := audit.Figures(*d.limit)
+		rec.Limit = &limit
+	}
+	if d.violation != nil {
+		rec.Reason = string(d.violation.reason)
+		rec.Message = d.violation.message
+		rec.Causes = d.violation.causes()
+	}
+	v.writeAudit(ctx, rec)
+}
+
+// writeAudit sends rec to the audit sink. Failures are logged, never blocking the admission.
+// Server-side dry-run requests are not written: nothing was actually admitted or denied.
+func (v *PodCustomValidator) writeAudit(ctx context.Context, rec audit.Record) {
+	if v.Audit == nil || dryRunRequest(ctx) {
+		return
+	}
+	if err := v.Audit.Write(ctx, rec); err != nil {
+		podlog.Error(err, "Failed to write audit record", "namespace", rec.Namespace, "pod", rec.Pod)
+	}
+}
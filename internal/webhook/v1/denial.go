@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// violation is what a pod would exceed: the message shown to the user, plus the reason code
+// and the figures of each exceeded resource (or the missing limits) for tooling to parse.
+type violation struct {
+	// resource is the resource label value of the metrics (the first resource exceeded)
+	resource string
+	reason   metav1.StatusReason
+	message  string
+	// figures lists each exceeded resource
+	figures []finopsv1.ViolatedResource
+	// missing lists the limits the pod lacks (budgets requiring limits)
+	missing []budget.MissingLimit
+}
+
+// exceededFigures describes an exceeded resource: used before the pod, limit, and what the pod requests.
+func exceededFigures(resourceName, used, limit, requested string) finopsv1.ViolatedResource {
+	return finopsv1.ViolatedResource{Resource: resourceName, Used: used, Limit: limit, Requested: requested}
+}
+
+// cpuFigures describes exceeded CPU, in Kubernetes quantities.
+func cpuFigures(used, limit, requested int64) finopsv1.ViolatedResource {
+	return exceededFigures(resourceCPU, cpuQuantity(used), cpuQuantity(limit), cpuQuantity(requested))
+}
+
+// memoryFigures describes exceeded Memory, in Kubernetes quantities.
+func memoryFigures(used, limit, requested int64) finopsv1.ViolatedResource {
+	return exceededFigures(resourceMemory, memoryQuantity(used), memoryQuantity(limit), memoryQuantity(requested))
+}
+
+// causes lists the exceeded resources as "used=1500m limit=2 requested=1", then points at
+// each limit the budget requires but the pod doesn't set.
+func (vi violation) causes() []metav1.StatusCause {
+	causes := make([]metav1.StatusCause, 0, len(vi.figures)+len(vi.missing))
+	for _, f := range vi.figures {
+		msg := fmt.Sprintf("used=%s limit=%s", f.Used, f.Limit)
+		if f.Requested != "" {
+			msg += " requested=" + f.Requested
+		}
+		causes = append(causes, metav1.StatusCause{Type: finopsv1.CauseResourceExceeded, Field: f.Resource, Message: msg})
+	}
+	for _, m := range vi.missing {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   m.Field(),
+			Message: fmt.Sprintf("container '%s' has no %s limit", m.Container, m.Resource),
+		})
+	}
+	return causes
+}
+
+func cpuQuantity(milli int64) string {
+	return resource.NewMilliQuantity(milli, resource.DecimalSI).String()
+}
+
+func memoryQuantity(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
+
+// statusError builds the denial returned to the API server: a Forbidden metav1.Status carrying
+// the reason code, the budget (ProjectBudget or ClusterBudget) and the causes in its details.
+func (vi violation) statusError(budgetObj client.Object) *apierrors.StatusError {
+	return &apierrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusForbidden,
+		Reason:  vi.reason,
+		Message: vi.message,
+		Details: &metav1.StatusDetails{
+			Name:   budgetObj.GetName(),
+			Group:  finopsv1.GroupVersion.Group,
+			Kind:   budgetKind(budgetObj),
+			Causes: vi.causes(),
+		},
+	}}
+}
+
+// budgetKind is the kind of a budget object (ProjectBudget or ClusterBudget).
+func budgetKind(budgetObj client.Object) string {
+	if _, ok := budgetObj.(*finopsv1.ClusterBudget); ok {
+		return "ClusterBudget"
+	}
+	return "ProjectBudget"
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// Values of the resource label: what a pod would have exceeded
+const (
+	resourceCPU            = "cpu"
+	resourceMemory         = "memory"
+	resourceMonthlyCost    = "monthly_cost"
+	resourceCPUCoreHours   = "cpu_core_hours"
+	resourceMemoryGiBHours = "memory_gib_hours"
+	resourceMissingLimits  = "missing_limits"
+	resourceParentBudget   = "parent_budget"
+)
+
+// Values of the phase label of the admission latency histogram
+const (
+	phaseListBudgets = "list_budgets"
+	phaseListPods    = "list_pods"
+	phaseEvaluation  = "evaluation"
+)
+
+// Values of the decision label of the admission decisions counter
+const (
+	decisionAllowed       = "allowed"
+	decisionDenied        = "denied"
+	decisionDryRun        = "dry_run"
+	decisionExempt        = "exempt"
+	decisionErrorFailOpen = "error_fail_open"
+)
+
+var (
+	// admissionPhaseDuration is the time a pod admission spends listing budgets, listing pods
+	// and everything else (evaluation, including the other API calls)
+	admissionPhaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "finops_admission_phase_duration_seconds",
+			Help:    "Time spent per phase (list_budgets, list_pods, evaluation) validating a pod creation",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+		},
+		[]string{"phase"},
+	)
+
+	// admissionDecisions counts the pod creations validated, by outcome
+	admissionDecisions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "finops_admission_decisions_total",
+			Help: "Total number of pod creations validated, by decision (allowed, denied, dry_run, exempt, error_fail_open)",
+		},
+		[]string{"decision"},
+	)
+
+	// rejectedPods counts the pods actually denied (budgets in Enforce mode)
+	rejectedPods = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "finops_rejected_pods_total",
+			Help: "Total number of pods rejected by the FinOps operator due to budget overflow",
+		},
+		[]string{"team_namespace", "budget", "resource"},
+	)
+
+	// dryRunViolations counts the pods that would have been denied, admitted because the budget is in DryRun mode
+	dryRunViolations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "finops_dryrun_violations_total",
+			Help: "Total number of pods that would have been rejected, admitted because the budget is in DryRun mode",
+		},
+		[]string{"team_namespace", "budget", "resource"},
+	)
+
+	// savedCpu counts the CPU limits of the rejected pods
+	savedCpu = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "finops_saved_cpu_millicores_total",
+			Help: "Total CPU millicores saved/prevented from being provisioned",
+		},
+		[]string{"team_namespace", "budget"},
+	)
+
+	// savedMemory counts the Memory limits of the rejected pods
+	savedMemory = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "finops_saved_memory_bytes_total",
+			Help: "Total Memory bytes saved/prevented from being provisioned",
+		},
+		[]string{"team_namespace", "budget"},
+	)
+
+	// autoResizedPods counts the pods shrunk by the mutating webhook to fit their budget
+	autoResizedPods = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "finops_autoresized_pods_total",
+			Help: "Total number of pods auto-sized to fit the remaining budget",
+		},
+		[]string{"team_namespace", "budget"},
+	)
+
+	// trimmedCpu counts the CPU removed from the limits of auto-sized pods
+	trimmedCpu = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "finops_autoresize_trimmed_cpu_millicores_total",
+			Help: "Total CPU millicores trimmed from the limits of auto-sized pods",
+		},
+		[]string{"team_namespace", "budget"},
+	)
+)
+
+func init() {
+	// Register the metrics in the global registry of controller-runtime
+	metrics.Registry.MustRegister(admissionPhaseDuration, admissionDecisions,
+		rejectedPods, dryRunViolations, savedCpu, savedMemory, autoResizedPods, trimmedCpu)
+}
+
+// recordRejection counts a denied pod and the resources it would have reserved.
+func recordRejection(namespace, budgetName, exceeded string, request budget.Resources) {
+	rejectedPods.WithLabelValues(namespace, budgetName, exceeded).Inc()
+	savedCpu.WithLabelValues(namespace, budgetName).Add(float64(request.CPUMilli))
+	savedMemory.WithLabelValues(namespace, budgetName).Add(float64(request.MemoryBytes))
+}
+
+// admissionDecision follows one pod admission: the time spent per phase and what it ran into.
+type admissionDecision struct {
+	start       time.Time
+	listBudgets time.Duration
+	listPods    time.Duration
+	// governed is set once a ProjectBudget or ClusterBudget applies to the namespace
+	governed bool
+	// dryRun is set when a violation was only reported
+	dryRun bool
+	// failedOpen is set when a check was skipped because of an error
+	failedOpen bool
+
+	// budgetName is the budget the pod was checked against (the exceeded one on violations)
+	budgetName string
+	// used and limit are the namespace usage before the pod and the limits in force of its budget
+	used, limit *budget.Resources
+	// violation is the last violation reported (denied or only reported in DryRun mode)
+	violation *violation
+}
+
+type admissionDecisionKey struct{}
+
+// withAdmissionDecision starts following an admission.
+func withAdmissionDecision(ctx context.Context) (context.Context, *admissionDecision) {
+	d := &admissionDecision{start: time.Now()}
+	return context.WithValue(ctx, admissionDecisionKey{}, d), d
+}
+
+// admissionDecisionFrom returns the admission followed by ctx. Outside of ValidateCreate
+// (e.g., while defaulting) it returns a decision that is never observed.
+func admissionDecisionFrom(ctx context.Context) *admissionDecision {
+	if d, ok := ctx.Value(admissionDecisionKey{}).(*admissionDecision); ok {
+		return d
+	}
+	return &admissionDecision{start: time.Now()}
+}
+
+// track adds the time elapsed since start to a listing phase.
+func (d *admissionDecision) track(phase string, start time.Time) {
+	switch phase {
+	case phaseListBudgets:
+		d.listBudgets += time.Since(start)
+	case phaseListPods:
+		d.listPods += time.Since(start)
+	}
+}
+
+// observe records the phase durations and the outcome of the admission (err is its denial),
+// and returns the outcome.
+func (d *admissionDecision) observe(err error) string {
+	evaluation := time.Since(d.start) - d.listBudgets - d.listPods
+	admissionPhaseDuration.WithLabelValues(phaseListBudgets).Observe(d.listBudgets.Seconds())
+	admissionPhaseDuration.WithLabelValues(phaseListPods).Observe(d.listPods.Seconds())
+	admissionPhaseDuration.WithLabelValues(phaseEvaluation).Observe(evaluation.Seconds())
+
+	decision := decisionAllowed
+	switch {
+	case err != nil:
+		decision = decisionDenied
+	case d.dryRun:
+		decision = decisionDryRun
+	case d.failedOpen:
+		decision = decisionErrorFailOpen
+	case !d.governed:
+		decision = decisionExempt
+	}
+	admissionDecisions.WithLabelValues(decision).Inc()
+	return decision
+}
@@ -3,75 +3,76 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
 	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
-	"github.com/prometheus/client_golang/prometheus"
-	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/audit"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/cost"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/tracing"
 )
 
+// DefaultedResourcesAnnotation lists the requests/limits injected into a pod (e.g., "app/limits.cpu=500m").
+const DefaultedResourcesAnnotation = "finops.acasa.acme/defaulted-resources"
+
 // log is for logging in this package.
 var podlog = logf.Log.WithName("pod-resource")
 
-// --- METRICS DEFINITION START ---
-var (
-	// Metric 1: Counter of rejections by namespace
-	rejectedPods = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "finops_rejected_pods_total",
-			Help: "Total number of pods rejected by the FinOps operator due to budget overflow",
-		},
-		[]string{"team_namespace"},
-	)
-
-	// Metric 2: Counter of CPU millicores saved by preventing pod creation
-	savedCpu = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "finops_saved_cpu_millicores_total",
-			Help: "Total CPU millicores saved/prevented from being provisioned",
-		},
-		[]string{"team_namespace"},
-	)
-)
-
-func init() {
-	// Register the metrics in the global registry of controller-runtime
-	metrics.Registry.MustRegister(rejectedPods, savedCpu)
-}
-
 // SetupPodWebhookWithManager registers the webhook for Pod in the manager.
-func SetupPodWebhookWithManager(mgr ctrl.Manager) error {
+// Admission decisions are written to auditSink (nil disables auditing), and violations are kept
+// as BudgetViolations for violationTTL (0 disables them).
+func SetupPodWebhookWithManager(mgr ctrl.Manager, auditSink audit.Sink, violationTTL time.Duration) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&corev1.Pod{}).
 		WithValidator(&PodCustomValidator{
-			Client:   mgr.GetClient(),
-			Decoder:  admission.NewDecoder(mgr.GetScheme()),
-			Recorder: mgr.GetEventRecorderFor("finops-webhook"),
+			Client:       mgr.GetClient(),
+			Decoder:      admission.NewDecoder(mgr.GetScheme()),
+			Recorder:     mgr.GetEventRecorderFor("finops-webhook"),
+			Audit:        auditSink,
+			ViolationTTL: violationTTL,
 		}).
 		WithDefaulter(&PodCustomValidator{
 			Client:   mgr.GetClient(),
 			Recorder: mgr.GetEventRecorderFor("finops-webhook"),
+			Audit:    auditSink,
 		}).
 		Complete()
 }
 
 // +kubebuilder:webhook:path=/mutate--v1-pod,mutating=true,failurePolicy=fail,sideEffects=None,groups="",resources=pods,verbs=create;update,versions=v1,name=mpod.kb.io,admissionReviewVersions=v1
-// +kubebuilder:webhook:path=/validate--v1-pod,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=pods,verbs=create;update,versions=v1,name=vpod.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate--v1-pod,mutating=false,failurePolicy=fail,sideEffects=NoneOnDryRun,groups="",resources=pods,verbs=create;update,versions=v1,name=vpod.kb.io,admissionReviewVersions=v1
 
 // PodCustomValidator struct
 type PodCustomValidator struct {
 	Client   client.Client
 	Decoder  admission.Decoder
 	Recorder record.EventRecorder
+	// Clock is used to evaluate time-based budget rules (bursts, schedules). Defaults to the real clock.
+	Clock clock.PassiveClock
+	// TracerProvider creates the admission spans. Defaults to the global provider (no-op unless configured).
+	TracerProvider trace.TracerProvider
+	// Audit receives a record of every admission decision. Auditing is disabled when nil.
+	Audit audit.Sink
+	// ViolationTTL is how long the BudgetViolations of denied or DryRun pods are kept. None are created when 0.
+	ViolationTTL time.Duration
 }
 
 var _ webhook.CustomValidator = &PodCustomValidator{}
@@ -79,6 +80,12 @@ var _ webhook.CustomValidator = &PodCustomValidator{}
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Pod.
 // +kubebuilder:rbac:groups=finops.acasa.acme,resources=projectbudgets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=costmodels,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=clusterbudgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=limitranges,verbs=get;list;watch
+// +kubebuilder:rbac:groups=finops.acasa.acme,resources=budgetviolations,verbs=create
 
 // Default implements admission.CustomDefaulter.
 // This function is called BEFORE validation. It allows us to modify the Pod on the fly.
@@ -87,48 +94,51 @@ func (v *PodCustomValidator) Default(ctx context.Context, obj runtime.Object) er
 	if !ok {
 		return fmt.Errorf("expected a Pod but got a %T", obj)
 	}
+	ctx, span := v.tracer().Start(ctx, "PodCustomValidator.Default",
+		trace.WithAttributes(tracing.NamespaceKey.String(pod.Namespace)))
+	defer span.End()
 
-	// 1. Safety Check: Only mutate if the user explicitly asks for it via Annotation.
-	// We don't want to surprise users by shrinking their databases silently.
-	if pod.Annotations["finops.acasa.acme/auto-resize"] != "true" {
+	// 1. Find the Budget
+	activeBudget, _, err := v.lookupBudget(ctx, pod.Namespace)
+	if err != nil {
+		return nil // If we can't list budgets, we don't touch anything
+	}
+	if activeBudget == nil {
 		return nil
 	}
 
-	podlog.Info("Mutating Pod: Checking for auto-sizing opportunities", "name", pod.Name)
-
-	// 2. Find the Budget
-	var budgetList finopsv1.ProjectBudgetList
-	if err := v.Client.List(ctx, &budgetList); err != nil {
-		return nil // If we can't list budgets, we don't touch anything
+	// 2. Containers without limits would count as zero: inject the defaults (new pods only,
+	// resources of existing pods are immutable)
+	if req, err := admission.RequestFromContext(ctx); err != nil || req.Operation == admissionv1.Create {
+		v.applyContainerDefaults(ctx, pod, activeBudget)
 	}
 
-	var activeBudget *finopsv1.ProjectBudget
-	for _, b := range budgetList.Items {
-		if b.Spec.TeamName == pod.Namespace {
-			activeBudget = &b
-			break
-		}
-	}
-	if activeBudget == nil {
+	// 3. Safety Check: Only resize if the user explicitly asks for it via Annotation.
+	// We don't want to surprise users by shrinking their databases silently.
+	if pod.Annotations["finops.acasa.acme/auto-resize"] != "true" {
 		return nil
 	}
 
-	// 3. Calculate Remaining Budget
+	podlog.Info("Mutating Pod: Checking for auto-sizing opportunities", "name", pod.Name)
+
+	// 4. Calculate Remaining Budget
 	currentCpu, _, err := v.calculateCurrentUsage(ctx, pod.Namespace)
 	if err != nil {
 		return nil
 	}
 
-	limitCpuQuantity, _ := resource.ParseQuantity(activeBudget.Spec.MaxCpuLimit)
-	limitCpuMilli := limitCpuQuantity.MilliValue()
-	remainingCpu := limitCpuMilli - currentCpu
+	limits, _, err := budget.EffectiveLimits(&activeBudget.Spec, v.now())
+	if err != nil {
+		return nil
+	}
+	remainingCpu := limits.CPUMilli - currentCpu
 
 	// If there is no budget left, we can't do anything (Validation will fail later)
 	if remainingCpu <= 0 {
 		return nil
 	}
 
-	// 4. Check if the Pod fits. If not, Resize it.
+	// 5. Check if the Pod fits. If not, Resize it.
 	// NOTE: For simplicity, we only resize the FIRST container.
 	// Complex logic would distribute the cut across all containers.
 	if len(pod.Spec.Containers) > 0 {
@@ -153,38 +163,76 @@ func (v *PodCustomValidator) Default(ctx context.Context, obj runtime.Object) er
 
 			// Record event
 			v.Recorder.Event(activeBudget, "Normal", "PodAutoSized", msg)
+			autoResizedPods.WithLabelValues(pod.Namespace, activeBudget.Name).Inc()
+			trimmedCpu.WithLabelValues(pod.Namespace, activeBudget.Name).Add(float64(oldCpu - remainingCpu))
+
+			rec := v.auditRecord(ctx, pod, decisionResized)
+			rec.Budget, rec.Message = activeBudget.Name, msg
+			v.writeAudit(ctx, rec)
 		}
 	}
 
 	return nil
 }
 
+// ValidateCreate implements webhook.CustomValidator. It records how long the checks took and their outcome.
 func (v *PodCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
 		return nil, fmt.Errorf("expected a Pod but got a %T", obj)
 	}
 
+	ctx, span := v.tracer().Start(ctx, "PodCustomValidator.ValidateCreate",
+		trace.WithAttributes(tracing.NamespaceKey.String(pod.Namespace)))
+	defer span.End()
+
+	ctx, decision := withAdmissionDecision(ctx)
+	warnings, err := v.validateCreate(ctx, pod)
+	outcome := decision.observe(err)
+	span.SetAttributes(tracing.DecisionKey.String(outcome))
+	v.auditDecision(ctx, pod, outcome, decision)
+	return warnings, err
+}
+
+// validateCreate checks a new pod against every budget that applies to its namespace.
+func (v *PodCustomValidator) validateCreate(ctx context.Context, pod *corev1.Pod) (admission.Warnings, error) {
+	decision := admissionDecisionFrom(ctx)
 	podlog.Info("Validating Pod creation for Financial Compliance", "name", pod.Name, "namespace", pod.Namespace)
 
 	// 1. Search for a budget for this namespace
-	var budgetList finopsv1.ProjectBudgetList
-	if err := v.Client.List(ctx, &budgetList); err != nil {
+	activeBudget, budgets, err := v.lookupBudget(ctx, pod.Namespace)
+	if err != nil {
 		podlog.Error(err, "Failed to list budgets, allowing pod safely")
+		decision.failedOpen = true
 		return nil, nil // Fail-open
 	}
 
-	var activeBudget *finopsv1.ProjectBudget
-	for _, b := range budgetList.Items {
-		if b.Spec.TeamName == pod.Namespace {
-			activeBudget = &b
-			break
-		}
-	}
-
-	// If no budget is found, we allow everything (fail-open)
+	// If no team budget is found, only the platform-wide caps apply
 	if activeBudget == nil {
-		return nil, nil
+		return nil, v.checkClusterBudgets(ctx, pod)
+	}
+	decision.governed = true
+	decision.budgetName = activeBudget.Name
+	trace.SpanFromContext(ctx).SetAttributes(tracing.BudgetKey.String(activeBudget.Name))
+
+	// Containers still without limits (no default applied) would slip through the budget
+	if d := activeBudget.Spec.Defaults; d != nil && d.RequireLimits {
+		if missing := budget.MissingLimits(pod, activeBudget.Spec.MaxMemoryLimit != ""); len(missing) > 0 {
+			names := make([]string, 0, len(missing))
+			for _, m := range missing {
+				names = append(names, m.String())
+			}
+			vi := violation{
+				resource: resourceMissingLimits,
+				reason:   finopsv1.ReasonLimitsRequired,
+				message: fmt.Sprintf("DENIED by FinOps: pods of team '%s' must set resource limits. Missing: %s",
+					pod.Namespace, strings.Join(names, ", ")),
+				missing: missing,
+			}
+			if err := v.handleViolation(ctx, activeBudget, pod, vi); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// 2. Calculate the cost of the NEW Pod (CPU & Memory)
@@ -203,147 +251,528 @@ func (v *PodCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Obj
 	}
 
 	// 3. Calculate CURRENT usage of the Namespace (CPU & Memory)
-	var existingPods corev1.PodList
-	if err := v.Client.List(ctx, &existingPods, client.InNamespace(pod.Namespace)); err != nil {
+	// (only running or pending pods count, completed/failed ones are ignored)
+	existingPods, usage, err := v.namespaceUsage(ctx, pod.Namespace)
+	if err != nil {
 		return nil, fmt.Errorf("failed to list existing pods: %v", err)
 	}
+	currentCpuUsage, currentMemUsage := usage.CPUMilli, usage.MemoryBytes
+
+	// Scheduled windows (e.g., nights/weekends) may replace the default limits
+	now := v.now()
+	limits, window, err := budget.EffectiveLimits(&activeBudget.Spec, now)
+	if err != nil {
+		podlog.Error(err, "Invalid limits in ProjectBudget, allowing pod safely", "budget", activeBudget.Name)
+		decision.failedOpen = true
+		return nil, nil // Fail-open
+	}
+	decision.used, decision.limit = &usage, &limits
+	windowNote := ""
+	if window != "" {
+		windowNote = fmt.Sprintf(" (window '%s')", window)
+	}
 
-	var currentCpuUsage int64 = 0
-	var currentMemUsage int64 = 0
+	// Burst: extra room the team may temporarily use on top of its budget
+	burst, err := budget.BurstAllowance(activeBudget, now)
+	if err != nil {
+		podlog.Error(err, "Invalid burst format in ProjectBudget", "budget", activeBudget.Name)
+	}
+	var warnings admission.Warnings
+	// Set when the team is short and lenders cover the difference
+	loanNote := ""
 
-	for _, p := range existingPods.Items {
-		// Only count running or pending pods (ignore completed/failed ones)
-		if p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
-			continue
+	// 4. Enforcement Logic: CPU Check
+	limitCpuMilli := limits.CPUMilli
+	totalCpuAfter := currentCpuUsage + newPodCpuCost
+	// Every resource exceeded is reported in the same denial
+	exceeded := violation{reason: finopsv1.ReasonBudgetExceeded}
+	var exceededMsgs []string
+
+	if totalCpuAfter > limitCpuMilli && totalCpuAfter <= limitCpuMilli+burst.CPUMilli {
+		warnings = append(warnings, fmt.Sprintf("FinOps: CPU burst in use for team '%s'. Used: %dm, Limit: %dm (+%dm burst), Request: %dm",
+			pod.Namespace, currentCpuUsage, limitCpuMilli, burst.CPUMilli, newPodCpuCost))
+	} else if totalCpuAfter > limitCpuMilli {
+		exceeded.resource = resourceCPU
+		exceeded.figures = append(exceeded.figures, cpuFigures(currentCpuUsage, limitCpuMilli, newPodCpuCost))
+		exceededMsgs = append(exceededMsgs, fmt.Sprintf("CPU Budget exceeded for team '%s'. Used: %dm, Limit: %dm%s, Request: %dm",
+			pod.Namespace, currentCpuUsage, limitCpuMilli, windowNote, newPodCpuCost))
+	}
+
+	// 5. Enforcement Logic: Memory Check (New Feature)
+	if limits.MemoryBytes > 0 {
+		limitMemBytes := limits.MemoryBytes
+		totalMemAfter := currentMemUsage + newPodMemCost
+
+		if totalMemAfter > limitMemBytes && totalMemAfter <= limitMemBytes+burst.MemoryBytes {
+			warnings = append(warnings, fmt.Sprintf("FinOps: RAM burst in use for team '%s'. Used: %d bytes, Limit: %d bytes (+%d bytes burst), Request: %d bytes",
+				pod.Namespace, currentMemUsage, limitMemBytes, burst.MemoryBytes, newPodMemCost))
+		} else if totalMemAfter > limitMemBytes {
+			if exceeded.resource == "" {
+				exceeded.resource = resourceMemory
+			}
+			exceeded.figures = append(exceeded.figures, memoryFigures(currentMemUsage, limitMemBytes, newPodMemCost))
+			exceededMsgs = append(exceededMsgs, fmt.Sprintf("RAM Budget exceeded for team '%s'. Used: %d bytes, Limit: %d bytes%s, Request: %d bytes",
+				pod.Namespace, currentMemUsage, limitMemBytes, windowNote, newPodMemCost))
 		}
+	}
 
-		for _, c := range p.Spec.Containers {
-			// CPU Sum
-			if cpu := c.Resources.Limits.Cpu(); cpu != nil {
-				currentCpuUsage += cpu.MilliValue()
+	// Lenders may cover what the team lacks (a single loan covers both CPU and Memory).
+	// A DryRun violation is only reported: the remaining checks (parents, cluster budgets) still apply.
+	if len(exceeded.figures) > 0 {
+		if loanNote = v.borrowFromLenders(ctx, activeBudget, budgets, pod, now); loanNote == "" {
+			exceeded.message = "DENIED by FinOps: " + strings.Join(exceededMsgs, "; ")
+			if err := v.handleViolation(ctx, activeBudget, pod, exceeded); err != nil {
+				return nil, err
 			}
-			// Memory Sum
-			if mem := c.Resources.Limits.Memory(); mem != nil {
-				currentMemUsage += mem.Value()
+		}
+	}
+
+	// 6. Enforcement Logic: Monthly Cost Check (only if a money budget is set)
+	if activeBudget.Spec.MaxMonthlyCost != "" {
+		if vi := v.checkMonthlyCost(ctx, activeBudget, pod, existingPods); vi != nil {
+			if err := v.handleViolation(ctx, activeBudget, pod, *vi); err != nil {
+				return nil, err
 			}
 		}
 	}
 
-	// 4. Enforcement Logic: CPU Check
-	limitCpuQuantity, _ := resource.ParseQuantity(activeBudget.Spec.MaxCpuLimit)
-	limitCpuMilli := limitCpuQuantity.MilliValue()
-	totalCpuAfter := currentCpuUsage + newPodCpuCost
+	// 7. Enforcement Logic: Cumulative Allowance (only if the budget blocks once it is used up)
+	if activeBudget.Spec.Accounting != nil && activeBudget.Spec.Accounting.BlockWhenExhausted {
+		dimension, err := budget.ExhaustedAllowance(activeBudget, now)
+		if err != nil {
+			podlog.Error(err, "Invalid accounting in ProjectBudget, allowing pod safely", "budget", activeBudget.Name)
+			decision.failedOpen = true
+		} else if dimension != "" {
+			consumption := activeBudget.Status.Consumption
+			vi := violation{
+				resource: resourceCPUCoreHours,
+				reason:   finopsv1.ReasonAllowanceExhausted,
+				message: fmt.Sprintf("DENIED by FinOps: %s allowance of the billing period exhausted for team '%s'. Consumed: %s CPU core-hours, %s Memory GiB-hours since %s",
+					dimension, pod.Namespace, consumption.CpuCoreHours, consumption.MemoryGiBHours,
+					consumption.PeriodStart.UTC().Format(time.RFC3339)),
+			}
+			if dimension == "Memory" {
+				vi.resource = resourceMemoryGiBHours
+				vi.figures = []finopsv1.ViolatedResource{exceededFigures(vi.resource,
+					consumption.MemoryGiBHours, activeBudget.Spec.Accounting.MaxMemoryGiBHours, "")}
+			} else {
+				vi.figures = []finopsv1.ViolatedResource{exceededFigures(vi.resource,
+					consumption.CpuCoreHours, activeBudget.Spec.Accounting.MaxCpuCoreHours, "")}
+			}
+			if err := v.handleViolation(ctx, activeBudget, pod, vi); err != nil {
+				return nil, err
+			}
+		}
+	}
 
-	if totalCpuAfter > limitCpuMilli {
-		violationMsg := fmt.Sprintf("DENIED by FinOps: CPU Budget exceeded for team '%s'. Used: %dm, Limit: %dm, Request: %dm",
-			pod.Namespace, currentCpuUsage, limitCpuMilli, newPodCpuCost)
+	// 8. Enforcement Logic: Parent Budgets (every ancestor must have room for the pod too)
+	if activeBudget.Spec.ParentRef != nil {
+		if err := v.checkAncestors(ctx, activeBudget, budgets, pod, now); err != nil {
+			return nil, err
+		}
+	}
 
-		if activeBudget.Spec.ValidationMode == finopsv1.DryRunMode {
-			dryRunMsg := fmt.Sprintf("[DRY-RUN] Violation detected but allowed: %s", violationMsg)
-			podlog.Info(dryRunMsg)
+	// 9. Enforcement Logic: Cluster Budgets (platform-wide caps over groups of namespaces)
+	if err := v.checkClusterBudgets(ctx, pod); err != nil {
+		return warnings, err
+	}
 
-			// We emit a specific event so the admin knows it WOULD have failed
-			v.Recorder.Event(activeBudget, "Warning", "DryRunViolation", dryRunMsg)
+	// The pod only fits thanks to the burst allowance: let the team know the clock is ticking
+	if len(warnings) > 0 {
+		v.Recorder.Event(activeBudget, "Normal", "BurstAdmitted", strings.Join(warnings, "; "))
+	}
 
-			// Metrics: We can still count it as rejected in metrics, or create a new metric "potential_savings"
-			// For now, let's keep counting it to see the impact
-			rejectedPods.WithLabelValues(pod.Namespace).Inc()
+	// The pod only fits thanks to headroom borrowed from other teams
+	if loanNote != "" {
+		v.Recorder.Event(activeBudget, "Normal", "LoanAdmitted", loanNote)
+		warnings = append(warnings, loanNote)
+	}
 
-			// CRITICAL: Return nil means "ALLOW"
-			return nil, nil
-		}
+	return warnings, nil
+}
+
+// applyContainerDefaults fills the missing requests/limits of the pod from the budget defaults and
+// the LimitRanges of its namespace, and records what was injected in an annotation.
+func (v *PodCustomValidator) applyContainerDefaults(ctx context.Context, pod *corev1.Pod, activeBudget *finopsv1.ProjectBudget) {
+	var limitRanges corev1.LimitRangeList
+	if err := v.Client.List(ctx, &limitRanges, client.InNamespace(pod.Namespace)); err != nil {
+		podlog.Error(err, "Failed to list LimitRanges, using the budget defaults only", "namespace", pod.Namespace)
+	}
+	limits, requests, err := budget.ContainerDefaultValues(activeBudget.Spec.Defaults, limitRanges.Items)
+	if err != nil {
+		podlog.Error(err, "Invalid defaults in ProjectBudget, not defaulting", "budget", activeBudget.Name)
+		return
+	}
 
-		podlog.Info(violationMsg)
+	injected := budget.ApplyContainerDefaults(pod, limits, requests)
+	if len(injected) == 0 {
+		return
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+	pod.Annotations[DefaultedResourcesAnnotation] = strings.Join(injected, ",")
+	podlog.Info("Injected default resources", "name", pod.Name, "namespace", pod.Namespace, "defaults", injected)
+}
 
-		// Record the event in the ProjectBudget CRD
-		v.Recorder.Event(activeBudget, "Warning", "BudgetExceeded", violationMsg)
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *PodCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	// Opcional: Implementar lógica similar si alguien escala verticalmente un pod existente
+	return nil, nil
+}
 
-		// Metrics
-		rejectedPods.WithLabelValues(pod.Namespace).Inc()
-		savedCpu.WithLabelValues(pod.Namespace).Add(float64(newPodCpuCost))
+// ValidateDelete implements webhook.CustomValidator.
+func (v *PodCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
 
-		return nil, fmt.Errorf("%s", violationMsg)
+// handleViolation applies the ValidationMode of the budget to a violation. In DryRun mode
+// the violation is only reported and nil is returned (ALLOW); otherwise the denial error is returned.
+func (v *PodCustomValidator) handleViolation(ctx context.Context, activeBudget *finopsv1.ProjectBudget, pod *corev1.Pod,
+	vi violation) error {
+	return v.reportViolation(ctx, activeBudget, activeBudget.Spec.ValidationMode, pod, vi)
+}
+
+// reportViolation records a violation of budgetObj (a ProjectBudget or a ClusterBudget) according to mode.
+// In Enforce mode the denial is a metav1.Status carrying the reason and the exceeded resources.
+func (v *PodCustomValidator) reportViolation(ctx context.Context, budgetObj client.Object, mode finopsv1.ValidationMode, pod *corev1.Pod,
+	vi violation) error {
+	violationMsg := vi.message
+	trace.SpanFromContext(ctx).AddEvent("violation", trace.WithAttributes(
+		tracing.BudgetKey.String(budgetObj.GetName()),
+		attribute.String("finops.resource", vi.resource),
+		attribute.String("finops.reason", string(vi.reason)),
+		attribute.String("finops.message", violationMsg),
+		attribute.Bool("finops.dry_run", mode == finopsv1.DryRunMode)))
+
+	decision := admissionDecisionFrom(ctx)
+	decision.budgetName, decision.violation = budgetObj.GetName(), &vi
+
+	if mode == finopsv1.DryRunMode {
+		// Metrics: DryRun violations are counted apart, to see the impact before enforcing
+		dryRunViolations.WithLabelValues(pod.Namespace, budgetObj.GetName(), vi.resource).Inc()
+		decision.dryRun = true
+
+		dryRunMsg := fmt.Sprintf("[DRY-RUN] Violation detected but allowed: %s", violationMsg)
+		podlog.Info(dryRunMsg)
+
+		// We emit a specific event so the admin knows it WOULD have failed
+		v.Recorder.Event(budgetObj, "Warning", "DryRunViolation", dryRunMsg)
+		v.recordOnWorkloads(ctx, pod, "Warning", "DryRunViolation",
+			fmt.Sprintf("[DRY-RUN] Pod %s would have been denied by FinOps budget %s: %s", podName(pod), budgetObj.GetName(), violationMsg))
+		v.recordViolation(ctx, budgetObj, pod, vi, finopsv1.ViolationDryRun)
+		return nil
 	}
 
-	// 5. Enforcement Logic: Memory Check (New Feature)
-	if activeBudget.Spec.MaxMemoryLimit != "" {
-		limitMemQuantity, err := resource.ParseQuantity(activeBudget.Spec.MaxMemoryLimit)
-		if err != nil {
-			podlog.Error(err, "Invalid memory limit format in ProjectBudget", "budget", activeBudget.Name)
-			// We don't block if the budget is malformed, just log error (Fail-Open behavior)
-		} else {
-			limitMemBytes := limitMemQuantity.Value()
-			totalMemAfter := currentMemUsage + newPodMemCost
+	podlog.Info(violationMsg)
+	recordRejection(pod.Namespace, budgetObj.GetName(), vi.resource, budget.PodResources(pod))
 
-			if totalMemAfter > limitMemBytes {
-				violationMsg := fmt.Sprintf("DENIED by FinOps: RAM Budget exceeded for team '%s'. Used: %d bytes, Limit: %d bytes, Request: %d bytes",
-					pod.Namespace, currentMemUsage, limitMemBytes, newPodMemCost)
+	// Record the event in the budget CRD
+	v.Recorder.Event(budgetObj, "Warning", "BudgetExceeded", violationMsg)
+	v.recordOnWorkloads(ctx, pod, "Warning", string(vi.reason),
+		fmt.Sprintf("Pod %s denied by FinOps budget %s: %s", podName(pod), budgetObj.GetName(), violationMsg))
+	v.recordViolation(ctx, budgetObj, pod, vi, finopsv1.ViolationDenied)
+	return vi.statusError(budgetObj)
+}
 
-				if activeBudget.Spec.ValidationMode == finopsv1.DryRunMode {
-					dryRunMsg := fmt.Sprintf("[DRY-RUN] Violation detected but allowed: %s", violationMsg)
-					podlog.Info(dryRunMsg)
+// checkMonthlyCost prices the namespace run-rate plus the new pod with the CostModel of the
+// budget. It returns the violation, or nil if the pod fits (or can't be priced).
+func (v *PodCustomValidator) checkMonthlyCost(ctx context.Context, activeBudget *finopsv1.ProjectBudget,
+	pod *corev1.Pod, existingPods []corev1.Pod) *violation {
 
-					// We emit a specific event so the admin knows it WOULD have failed
-					v.Recorder.Event(activeBudget, "Warning", "DryRunViolation", dryRunMsg)
+	maxCost, err := cost.ParseAmount(activeBudget.Spec.MaxMonthlyCost)
+	if err != nil {
+		podlog.Error(err, "Invalid maxMonthlyCost in ProjectBudget, allowing pod safely", "budget", activeBudget.Name)
+		admissionDecisionFrom(ctx).failedOpen = true
+		return nil // Fail-open
+	}
+	model, err := cost.Lookup(ctx, v.Client, &activeBudget.Spec)
+	if err != nil {
+		podlog.Error(err, "Failed to load CostModel, allowing pod safely", "budget", activeBudget.Name)
+		admissionDecisionFrom(ctx).failedOpen = true
+		return nil // Fail-open
+	}
 
-					// Metrics: We can still count it as rejected in metrics, or create a new metric "potential_savings"
-					// For now, let's keep counting it to see the impact
-					rejectedPods.WithLabelValues(pod.Namespace).Inc()
+	// Existing claims keep costing money whether a pod mounts them or not
+	var claims corev1.PersistentVolumeClaimList
+	if err := v.Client.List(ctx, &claims, client.InNamespace(pod.Namespace)); err != nil {
+		podlog.Error(err, "Failed to list claims, allowing pod safely", "namespace", pod.Namespace)
+		admissionDecisionFrom(ctx).failedOpen = true
+		return nil // Fail-open
+	}
 
-					// CRITICAL: Return nil means "ALLOW"
-					return nil, nil
-				}
+	currentCost := model.NamespaceMonthlyCost(existingPods, claims.Items)
+	newPodCost := model.PodMonthlyCost(pod)
+	if currentCost+newPodCost <= maxCost {
+		return nil
+	}
+	return &violation{
+		resource: resourceMonthlyCost,
+		reason:   finopsv1.ReasonMonthlyCostExceeded,
+		message: fmt.Sprintf("DENIED by FinOps: Monthly cost budget exceeded for team '%s'. Current: %s %s/month, Limit: %s %s/month, Request: %s %s/month",
+			pod.Namespace, cost.FormatAmount(currentCost), model.Currency, activeBudget.Spec.MaxMonthlyCost, model.Currency,
+			cost.FormatAmount(newPodCost), model.Currency),
+		figures: []finopsv1.ViolatedResource{exceededFigures(resourceMonthlyCost, cost.FormatAmount(currentCost),
+			activeBudget.Spec.MaxMonthlyCost, cost.FormatAmount(newPodCost))},
+	}
+}
 
-				podlog.Info(violationMsg)
+// checkAncestors checks the new pod against the remaining headroom of every ancestor of the
+// budget, counting the pods of each ancestor's whole subtree. Each exceeded ancestor is reported
+// according to its own ValidationMode, and the first one enforcing its limits denies the pod. A
+// missing or looping parent is a violation of the budget itself: its team stays blocked until it
+// is re-parented.
+func (v *PodCustomValidator) checkAncestors(ctx context.Context, activeBudget *finopsv1.ProjectBudget,
+	budgets []finopsv1.ProjectBudget, pod *corev1.Pod, now time.Time) error {
+
+	ancestors, err := budget.Ancestors(activeBudget, budgets)
+	if err != nil {
+		return v.handleViolation(ctx, activeBudget, pod, violation{
+			resource: resourceParentBudget,
+			reason:   finopsv1.ReasonParentBudgetMissing,
+			message: fmt.Sprintf("DENIED by FinOps: Invalid budget hierarchy for team '%s': %v",
+				pod.Namespace, err),
+		})
+	}
 
-				// Record the event in the ProjectBudget CRD
-				v.Recorder.Event(activeBudget, "Warning", "BudgetExceeded", violationMsg)
+	request := budget.PodResources(pod)
+	usageByNamespace := map[string]budget.Resources{}
+	for _, ancestor := range ancestors {
+		limits, _, err := budget.EffectiveLimits(&ancestor.Spec, now)
+		if err != nil {
+			podlog.Error(err, "Invalid limits in parent ProjectBudget, skipping it", "budget", ancestor.Name)
+			continue
+		}
 
-				// Note: We could add a 'savedMemory' metric here in the future
-				return nil, fmt.Errorf("%s", violationMsg)
+		var used budget.Resources
+		for _, ns := range budget.SubtreeNamespaces(ancestor, budgets) {
+			nsUsage, cached := usageByNamespace[ns]
+			if !cached {
+				var cpu, mem int64
+				if cpu, mem, err = v.calculateCurrentUsage(ctx, ns); err != nil {
+					podlog.Error(err, "Failed to list pods of parent budget, allowing pod safely", "namespace", ns)
+					admissionDecisionFrom(ctx).failedOpen = true
+					return nil // Fail-open
+				}
+				nsUsage = budget.Resources{CPUMilli: cpu, MemoryBytes: mem}
+				usageByNamespace[ns] = nsUsage
 			}
+			used = used.Add(nsUsage)
 		}
-	}
 
-	return nil, nil
+		vi := violation{reason: finopsv1.ReasonParentBudgetExceeded}
+		var msgs []string
+		after := used.Add(request)
+		if after.CPUMilli > limits.CPUMilli {
+			vi.resource = resourceCPU
+			vi.figures = append(vi.figures, cpuFigures(used.CPUMilli, limits.CPUMilli, request.CPUMilli))
+			msgs = append(msgs, fmt.Sprintf("CPU Budget of parent '%s' exceeded by team '%s'. Used: %dm, Limit: %dm, Request: %dm",
+				ancestor.Name, pod.Namespace, used.CPUMilli, limits.CPUMilli, request.CPUMilli))
+		}
+		if limits.MemoryBytes > 0 && after.MemoryBytes > limits.MemoryBytes {
+			if vi.resource == "" {
+				vi.resource = resourceMemory
+			}
+			vi.figures = append(vi.figures, memoryFigures(used.MemoryBytes, limits.MemoryBytes, request.MemoryBytes))
+			msgs = append(msgs, fmt.Sprintf("RAM Budget of parent '%s' exceeded by team '%s'. Used: %d bytes, Limit: %d bytes, Request: %d bytes",
+				ancestor.Name, pod.Namespace, used.MemoryBytes, limits.MemoryBytes, request.MemoryBytes))
+		}
+		if len(msgs) > 0 {
+			vi.message = "DENIED by FinOps: " + strings.Join(msgs, "; ")
+			// A DryRun ancestor only reports: the ones above it may still enforce their limits
+			if err := v.handleViolation(ctx, ancestor, pod, vi); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
-// ValidateUpdate implements webhook.CustomValidator.
-func (v *PodCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
-	// Opcional: Implementar lógica similar si alguien escala verticalmente un pod existente
-	return nil, nil
+// borrowFromLenders checks whether the lenders of the budget can cover everything the team
+// would use beyond its limits with the new pod. It returns the warning telling the team what
+// it borrows, or "" if the loans fall short (or can't be computed).
+func (v *PodCustomValidator) borrowFromLenders(ctx context.Context, activeBudget *finopsv1.ProjectBudget,
+	budgets []finopsv1.ProjectBudget, pod *corev1.Pod, now time.Time) string {
+
+	if len(budget.Lenders(activeBudget, budgets)) == 0 {
+		return ""
+	}
+
+	usage := map[string]budget.Resources{}
+	for _, ns := range budget.LendingNamespaces(activeBudget, budgets) {
+		cpu, mem, err := v.calculateCurrentUsage(ctx, ns)
+		if err != nil {
+			podlog.Error(err, "Failed to list pods of lending budget", "namespace", ns)
+			return ""
+		}
+		usage[ns] = budget.Resources{CPUMilli: cpu, MemoryBytes: mem}
+	}
+	usage[pod.Namespace] = usage[pod.Namespace].Add(budget.PodResources(pod))
+
+	loans, err := budget.AllocateLoans(budgets, usage, now)
+	if err != nil {
+		podlog.Error(err, "Invalid limits or lending policy in ProjectBudget", "budget", activeBudget.Name)
+		return ""
+	}
+	limits, _, err := budget.EffectiveLimits(&activeBudget.Spec, now)
+	if err != nil {
+		return ""
+	}
+	key := budget.Key(activeBudget)
+	if !budget.Borrowed(loans, key).Covers(usage[pod.Namespace].Excess(limits)) {
+		return ""
+	}
+
+	var parts []string
+	for _, loan := range loans {
+		if loan.Borrower == key {
+			parts = append(parts, fmt.Sprintf("%dm CPU and %d bytes of Memory from '%s'",
+				loan.CPUMilli, loan.MemoryBytes, loan.Lender.Name))
+		}
+	}
+	return fmt.Sprintf("FinOps: team '%s' is over its budget and borrowing %s. Lenders may reclaim it when they need the capacity back",
+		pod.Namespace, strings.Join(parts, ", "))
 }
 
-// ValidateDelete implements webhook.CustomValidator.
-func (v *PodCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
-	return nil, nil
+// checkClusterBudgets checks the new pod against every ClusterBudget selecting its namespace,
+// counting the pods of all the namespaces each one selects. Broken selectors or limits and
+// API errors allow the pod (fail-open).
+func (v *PodCustomValidator) checkClusterBudgets(ctx context.Context, pod *corev1.Pod) error {
+	decision := admissionDecisionFrom(ctx)
+	var clusterBudgets finopsv1.ClusterBudgetList
+	listStart := time.Now()
+	err := v.Client.List(ctx, &clusterBudgets)
+	decision.track(phaseListBudgets, listStart)
+	if err != nil {
+		podlog.Error(err, "Failed to list cluster budgets, allowing pod safely")
+		decision.failedOpen = true
+		return nil // Fail-open
+	}
+	if len(clusterBudgets.Items) == 0 {
+		return nil
+	}
+
+	var namespaces corev1.NamespaceList
+	if err := v.Client.List(ctx, &namespaces); err != nil {
+		podlog.Error(err, "Failed to list namespaces, allowing pod safely")
+		decision.failedOpen = true
+		return nil // Fail-open
+	}
+
+	request := budget.PodResources(pod)
+	usageByNamespace := map[string]budget.Resources{}
+	for i := range clusterBudgets.Items {
+		cb := &clusterBudgets.Items[i]
+		selector, err := budget.NamespaceSelector(&cb.Spec)
+		if err != nil {
+			podlog.Error(err, "Invalid namespace selector in ClusterBudget, skipping it", "budget", cb.Name)
+			continue
+		}
+		selected := budget.SelectedNamespaces(selector, namespaces.Items)
+		if !slices.Contains(selected, pod.Namespace) {
+			continue
+		}
+		decision.governed = true
+		limits, err := budget.ClusterLimits(&cb.Spec)
+		if err != nil {
+			podlog.Error(err, "Invalid limits in ClusterBudget, skipping it", "budget", cb.Name)
+			continue
+		}
+
+		var used budget.Resources
+		for _, ns := range selected {
+			nsUsage, cached := usageByNamespace[ns]
+			if !cached {
+				var cpu, mem int64
+				if cpu, mem, err = v.calculateCurrentUsage(ctx, ns); err != nil {
+					podlog.Error(err, "Failed to list pods of cluster budget, allowing pod safely", "namespace", ns)
+					decision.failedOpen = true
+					return nil // Fail-open
+				}
+				nsUsage = budget.Resources{CPUMilli: cpu, MemoryBytes: mem}
+				usageByNamespace[ns] = nsUsage
+			}
+			used = used.Add(nsUsage)
+		}
+
+		vi := violation{reason: finopsv1.ReasonClusterBudgetExceeded}
+		var msgs []string
+		after := used.Add(request)
+		if after.CPUMilli > limits.CPUMilli {
+			vi.resource = resourceCPU
+			vi.figures = append(vi.figures, cpuFigures(used.CPUMilli, limits.CPUMilli, request.CPUMilli))
+			msgs = append(msgs, fmt.Sprintf("CPU ClusterBudget '%s' exceeded by namespace '%s'. Used: %dm, Limit: %dm, Request: %dm",
+				cb.Name, pod.Namespace, used.CPUMilli, limits.CPUMilli, request.CPUMilli))
+		}
+		if limits.MemoryBytes > 0 && after.MemoryBytes > limits.MemoryBytes {
+			if vi.resource == "" {
+				vi.resource = resourceMemory
+			}
+			vi.figures = append(vi.figures, memoryFigures(used.MemoryBytes, limits.MemoryBytes, request.MemoryBytes))
+			msgs = append(msgs, fmt.Sprintf("RAM ClusterBudget '%s' exceeded by namespace '%s'. Used: %d bytes, Limit: %d bytes, Request: %d bytes",
+				cb.Name, pod.Namespace, used.MemoryBytes, limits.MemoryBytes, request.MemoryBytes))
+		}
+		if len(msgs) > 0 {
+			vi.message = "DENIED by FinOps: " + strings.Join(msgs, "; ")
+			// A DryRun ClusterBudget only reports: keep checking the others
+			if err := v.reportViolation(ctx, cb, cb.Spec.ValidationMode, pod, vi); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // calculateCurrentUsage sums up the CPU and Memory limits of all active Pods in the namespace.
 // Returns: (cpuMillis, memoryBytes, error)
 func (v *PodCustomValidator) calculateCurrentUsage(ctx context.Context, namespace string) (int64, int64, error) {
+	_, usage, err := v.namespaceUsage(ctx, namespace)
+	return usage.CPUMilli, usage.MemoryBytes, err
+}
+
+// namespaceUsage lists the pods of the namespace and sums the limits of the active ones.
+func (v *PodCustomValidator) namespaceUsage(ctx context.Context, namespace string) ([]corev1.Pod, budget.Resources, error) {
+	ctx, span := v.tracer().Start(ctx, "usage.calculate", trace.WithAttributes(tracing.NamespaceKey.String(namespace)))
+	defer span.End()
+	defer admissionDecisionFrom(ctx).track(phaseListPods, time.Now())
+
 	var existingPods corev1.PodList
 	if err := v.Client.List(ctx, &existingPods, client.InNamespace(namespace)); err != nil {
-		return 0, 0, err
+		span.SetStatus(codes.Error, err.Error())
+		return nil, budget.Resources{}, err
 	}
+	return existingPods.Items, budget.Usage(existingPods.Items), nil
+}
 
-	var currentCpuUsage int64 = 0
-	var currentMemUsage int64 = 0
+// lookupBudget lists the budgets and returns the one governing namespace (nil if none) with all of them.
+func (v *PodCustomValidator) lookupBudget(ctx context.Context, namespace string) (*finopsv1.ProjectBudget, []finopsv1.ProjectBudget, error) {
+	ctx, span := v.tracer().Start(ctx, "budget.lookup", trace.WithAttributes(tracing.NamespaceKey.String(namespace)))
+	defer span.End()
+	defer admissionDecisionFrom(ctx).track(phaseListBudgets, time.Now())
 
-	for _, p := range existingPods.Items {
-		// Only count running or pending pods (ignore completed/failed ones)
-		if p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
-			continue
+	var budgetList finopsv1.ProjectBudgetList
+	if err := v.Client.List(ctx, &budgetList); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	for i := range budgetList.Items {
+		if budgetList.Items[i].Spec.TeamName == namespace {
+			span.SetAttributes(tracing.BudgetKey.String(budgetList.Items[i].Name))
+			return &budgetList.Items[i], budgetList.Items, nil
 		}
+	}
+	return nil, budgetList.Items, nil
+}
 
-		for _, c := range p.Spec.Containers {
-			if cpu := c.Resources.Limits.Cpu(); cpu != nil {
-				currentCpuUsage += cpu.MilliValue()
-			}
-			if mem := c.Resources.Limits.Memory(); mem != nil {
-				currentMemUsage += mem.Value()
-			}
-		}
+// tracer returns the tracer of the configured provider.
+func (v *PodCustomValidator) tracer() trace.Tracer {
+	return tracing.Tracer(v.TracerProvider)
+}
+
+// now returns the current time according to the configured clock.
+func (v *PodCustomValidator) now() time.Time {
+	if v.Clock == nil {
+		return time.Now()
 	}
-	return currentCpuUsage, currentMemUsage, nil
+	return v.Clock.Now()
 }
@@ -17,13 +17,65 @@ limitations under the License.
 package v1
 
 import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
-	// TODO (user): Add any additional imports if needed
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/audit"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/tracing"
 )
 
+// newFakeValidator builds a PodCustomValidator backed by a fake client holding objs.
+func newFakeValidator(now time.Time, objs ...client.Object) *PodCustomValidator {
+	s := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(s)).To(Succeed())
+	Expect(finopsv1.AddToScheme(s)).To(Succeed())
+
+	return &PodCustomValidator{
+		Client:   fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(),
+		Recorder: record.NewFakeRecorder(10),
+		Clock:    clocktesting.NewFakePassiveClock(now),
+	}
+}
+
+// newBudgetPod returns a running pod in namespace ns with the given CPU limit.
+func newBudgetPod(name, ns, cpu string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name: "app",
+			Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse(cpu),
+			}},
+		}}},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
 var _ = Describe("Pod Webhook", func() {
 	var (
 		obj       *corev1.Pod
@@ -68,4 +120,820 @@ var _ = Describe("Pod Webhook", func() {
 		// })
 	})
 
+	Context("When a budget allows bursting", func() {
+		var (
+			now         time.Time
+			burstBudget *finopsv1.ProjectBudget
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+			burstBudget = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "burst-budget", Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    "team-burst",
+					MaxCpuLimit: "200m",
+					Burst: &finopsv1.BurstSpec{
+						ExtraCpuLimit: "500m",
+						MaxDuration:   metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+		})
+
+		It("Should admit a pod that only fits within the burst with a warning", func() {
+			v := newFakeValidator(now, burstBudget, newBudgetPod("existing", "team-burst", "100m"))
+
+			warnings, err := v.ValidateCreate(ctx, newBudgetPod("incident", "team-burst", "300m"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("CPU burst in use")))
+		})
+
+		It("Should deny a pod that exceeds the burst allowance", func() {
+			v := newFakeValidator(now, burstBudget, newBudgetPod("existing", "team-burst", "100m"))
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("incident", "team-burst", "700m"))
+			Expect(err).To(MatchError(ContainSubstring("CPU Budget exceeded")))
+		})
+
+		It("Should fall back to the plain budget once the burst window expired", func() {
+			burstBudget.Status.BurstStartTime = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+			v := newFakeValidator(now, burstBudget, newBudgetPod("existing", "team-burst", "100m"))
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("incident", "team-burst", "300m"))
+			Expect(err).To(MatchError(ContainSubstring("CPU Budget exceeded")))
+		})
+	})
+	Context("When a budget has scheduled windows", func() {
+		var nightBudget *finopsv1.ProjectBudget
+
+		BeforeEach(func() {
+			nightBudget = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev-budget", Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    "team-dev",
+					MaxCpuLimit: "4000m",
+					Schedules: []finopsv1.BudgetWindow{{
+						Name:        "nights",
+						Schedule:    "0 20 * * *",
+						Duration:    metav1.Duration{Duration: 12 * time.Hour},
+						MaxCpuLimit: "200m",
+					}},
+				},
+			}
+		})
+
+		It("Should admit a large pod during working hours", func() {
+			v := newFakeValidator(time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC), nightBudget)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("build", "team-dev", "1000m"))).To(BeEmpty())
+		})
+
+		It("Should deny the same pod at night using the window limit", func() {
+			v := newFakeValidator(time.Date(2026, 3, 4, 23, 0, 0, 0, time.UTC), nightBudget)
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("build", "team-dev", "1000m"))
+			Expect(err).To(MatchError(ContainSubstring("Limit: 200m (window 'nights')")))
+		})
+	})
+	Context("When a budget has a monthly cost limit", func() {
+		var (
+			now        time.Time
+			costModel  *finopsv1.CostModel
+			costBudget *finopsv1.ProjectBudget
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+			costModel = &finopsv1.CostModel{
+				ObjectMeta: metav1.ObjectMeta{Name: finopsv1.DefaultCostModelName},
+				Spec: finopsv1.CostModelSpec{
+					Currency:           "USD",
+					CpuHourPrice:       "0.1",
+					MemoryGiBHourPrice: "0.01",
+				},
+			}
+			costBudget = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "cost-budget", Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:       "team-cost",
+					MaxCpuLimit:    "10",
+					MaxMonthlyCost: "150",
+				},
+			}
+		})
+
+		It("Should admit a pod whose projected cost fits", func() {
+			// 1 vCPU * 0.1 * 730 = 73 USD/month
+			v := newFakeValidator(now, costModel, costBudget, newBudgetPod("existing", "team-cost", "1"))
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("api", "team-cost", "500m"))).To(BeEmpty())
+		})
+
+		It("Should deny a pod whose projected cost exceeds the monthly limit", func() {
+			v := newFakeValidator(now, costModel, costBudget, newBudgetPod("existing", "team-cost", "1"))
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("api", "team-cost", "2"))
+			Expect(err).To(MatchError(ContainSubstring("Monthly cost budget exceeded for team 'team-cost'. Current: 73.00 USD/month")))
+		})
+
+		It("Should use the CostModel referenced by the budget", func() {
+			costModel.Name = "spot"
+			costModel.Spec.CpuHourPrice = "0.01"
+			costBudget.Spec.CostModelRef = "spot"
+			v := newFakeValidator(now, costModel, costBudget, newBudgetPod("existing", "team-cost", "1"))
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("api", "team-cost", "2"))).To(BeEmpty())
+		})
+
+		It("Should fail open when no CostModel exists", func() {
+			v := newFakeValidator(now, costBudget, newBudgetPod("existing", "team-cost", "1"))
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("api", "team-cost", "2"))).To(BeEmpty())
+		})
+	})
+	Context("When a budget blocks once its cumulative allowance is used up", func() {
+		var (
+			now             time.Time
+			accountedBudget *finopsv1.ProjectBudget
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 20, 10, 0, 0, 0, time.UTC)
+			accountedBudget = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "accounted-budget", Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    "team-batch",
+					MaxCpuLimit: "4",
+					Accounting: &finopsv1.AccountingSpec{
+						Period:             finopsv1.BillingPeriodMonthly,
+						MaxCpuCoreHours:    "500",
+						BlockWhenExhausted: true,
+					},
+				},
+				Status: finopsv1.ProjectBudgetStatus{Consumption: &finopsv1.ConsumptionStatus{
+					PeriodStart:    metav1.Time{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+					CpuCoreHours:   "512.000",
+					MemoryGiBHours: "0.000",
+				}},
+			}
+		})
+
+		It("Should deny new pods for the rest of the period", func() {
+			v := newFakeValidator(now, accountedBudget)
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("job", "team-batch", "100m"))
+			Expect(err).To(MatchError(ContainSubstring("CPU allowance of the billing period exhausted")))
+		})
+
+		It("Should admit pods once a new period started", func() {
+			v := newFakeValidator(time.Date(2026, 4, 1, 0, 1, 0, 0, time.UTC), accountedBudget)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("job", "team-batch", "100m"))).To(BeEmpty())
+		})
+
+		It("Should only report the exhaustion when blocking is disabled", func() {
+			accountedBudget.Spec.Accounting.BlockWhenExhausted = false
+			v := newFakeValidator(now, accountedBudget)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("job", "team-batch", "100m"))).To(BeEmpty())
+		})
+	})
+	Context("When a budget has a parent", func() {
+		var (
+			now        time.Time
+			department *finopsv1.ProjectBudget
+			team       *finopsv1.ProjectBudget
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+			department = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "data-shared", MaxCpuLimit: "1000m"},
+			}
+			team = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    "team-a",
+					MaxCpuLimit: "800m",
+					ParentRef:   &finopsv1.BudgetReference{Name: "data"},
+				},
+			}
+		})
+
+		It("Should admit a pod that fits in the team and its parent", func() {
+			v := newFakeValidator(now, department, team, newBudgetPod("shared", "data-shared", "200m"))
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("api", "team-a", "500m"))).To(BeEmpty())
+		})
+
+		It("Should deny a pod that fits in the team but not in the parent headroom", func() {
+			v := newFakeValidator(now, department, team, newBudgetPod("shared", "data-shared", "600m"))
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("api", "team-a", "500m"))
+			Expect(err).To(MatchError(ContainSubstring("CPU Budget of parent 'data' exceeded by team 'team-a'. Used: 600m, Limit: 1000m")))
+		})
+
+		It("Should deny a pod over an Enforce grandparent even when its DryRun parent is exceeded", func() {
+			department.Spec.ValidationMode = finopsv1.DryRunMode
+			department.Spec.ParentRef = &finopsv1.BudgetReference{Name: "org"}
+			org := &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "org", Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "org-shared", MaxCpuLimit: "1000m"},
+			}
+			v := newFakeValidator(now, org, department, team, newBudgetPod("shared", "data-shared", "600m"))
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("api", "team-a", "500m"))
+			Expect(err).To(MatchError(ContainSubstring("CPU Budget of parent 'org' exceeded by team 'team-a'. Used: 600m, Limit: 1000m")))
+		})
+
+		It("Should deny pods of a team whose parent was deleted", func() {
+			v := newFakeValidator(now, team)
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("api", "team-a", "100m"))
+			Expect(err).To(MatchError(ContainSubstring("Invalid budget hierarchy for team 'team-a': parent budget default/data not found")))
+
+			status := err.(apierrors.APIStatus).Status()
+			Expect(status.Reason).To(Equal(finopsv1.ReasonParentBudgetMissing))
+			Expect(status.Details.Name).To(Equal("team-a"))
+		})
+
+		It("Should only report the missing parent in DryRun mode", func() {
+			team.Spec.ValidationMode = finopsv1.DryRunMode
+			v := newFakeValidator(now, team)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("api", "team-a", "100m"))).To(BeEmpty())
+		})
+	})
+	Context("When a ClusterBudget selects the namespace", func() {
+		var (
+			now       time.Time
+			sandboxes *finopsv1.ClusterBudget
+			objs      []client.Object
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+			sandboxes = &finopsv1.ClusterBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "sandboxes"},
+				Spec: finopsv1.ClusterBudgetSpec{
+					NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "sandbox"}},
+					MaxCpuLimit:       "1000m",
+				},
+			}
+			objs = []client.Object{
+				sandboxes,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "sandbox-a", Labels: map[string]string{"env": "sandbox"}}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "sandbox-b", Labels: map[string]string{"env": "sandbox"}}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}},
+				newBudgetPod("existing", "sandbox-a", "700m"),
+			}
+		})
+
+		It("Should deny a pod over the shared cap even without a team budget", func() {
+			v := newFakeValidator(now, objs...)
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("notebook", "sandbox-b", "500m"))
+			Expect(err).To(MatchError(ContainSubstring("CPU ClusterBudget 'sandboxes' exceeded by namespace 'sandbox-b'. Used: 700m, Limit: 1000m")))
+		})
+
+		It("Should deny a pod that fits its team budget but not the ClusterBudget", func() {
+			teamBudget := &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "sandbox-b", Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "sandbox-b", MaxCpuLimit: "2000m"},
+			}
+			v := newFakeValidator(now, append(objs, teamBudget)...)
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("notebook", "sandbox-b", "500m"))
+			Expect(err).To(MatchError(ContainSubstring("ClusterBudget 'sandboxes' exceeded")))
+		})
+
+		It("Should deny a pod over the ClusterBudget even when its DryRun team budget is exceeded", func() {
+			teamBudget := &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "sandbox-b", Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:       "sandbox-b",
+					MaxCpuLimit:    "100m",
+					ValidationMode: finopsv1.DryRunMode,
+				},
+			}
+			v := newFakeValidator(now, append(objs, teamBudget)...)
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("notebook", "sandbox-b", "500m"))
+			Expect(err).To(MatchError(ContainSubstring("ClusterBudget 'sandboxes' exceeded")))
+		})
+
+		It("Should deny a pod over an Enforce ClusterBudget checked after a DryRun one", func() {
+			trial := &finopsv1.ClusterBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "all-sandboxes-trial"},
+				Spec: finopsv1.ClusterBudgetSpec{
+					NamespaceSelector: sandboxes.Spec.NamespaceSelector,
+					MaxCpuLimit:       "500m",
+					ValidationMode:    finopsv1.DryRunMode,
+				},
+			}
+			v := newFakeValidator(now, append(objs, trial)...)
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("notebook", "sandbox-b", "500m"))
+			Expect(err).To(MatchError(ContainSubstring("ClusterBudget 'sandboxes' exceeded")))
+		})
+
+		It("Should ignore namespaces outside the selector", func() {
+			v := newFakeValidator(now, objs...)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("api", "prod", "5000m"))).To(BeEmpty())
+		})
+
+		It("Should only report the violation in DryRun mode", func() {
+			sandboxes.Spec.ValidationMode = finopsv1.DryRunMode
+			v := newFakeValidator(now, objs...)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("notebook", "sandbox-b", "500m"))).To(BeEmpty())
+		})
+	})
+	Context("When another team lends its headroom", func() {
+		var (
+			now      time.Time
+			lender   *finopsv1.ProjectBudget
+			borrower *finopsv1.ProjectBudget
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+			lender = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-lender", Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:    "team-lender",
+					MaxCpuLimit: "2000m",
+					Lending: &finopsv1.LendingSpec{
+						Borrowers:  []finopsv1.BudgetReference{{Name: "team-borrower"}},
+						MaxLentCpu: "500m",
+					},
+				},
+			}
+			borrower = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-borrower", Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "team-borrower", MaxCpuLimit: "1000m"},
+			}
+		})
+
+		It("Should admit a pod covered by the lender with a warning", func() {
+			v := newFakeValidator(now, lender, borrower, newBudgetPod("existing", "team-borrower", "800m"))
+
+			warnings, err := v.ValidateCreate(ctx, newBudgetPod("batch", "team-borrower", "500m"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("borrowing 300m CPU and 0 bytes of Memory from 'team-lender'")))
+		})
+
+		It("Should deny a pod beyond the lending cap", func() {
+			v := newFakeValidator(now, lender, borrower, newBudgetPod("existing", "team-borrower", "800m"))
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("batch", "team-borrower", "800m"))
+			Expect(err).To(MatchError(ContainSubstring("CPU Budget exceeded for team 'team-borrower'")))
+		})
+
+		It("Should deny a pod when the lender uses its own headroom", func() {
+			v := newFakeValidator(now, lender, borrower,
+				newBudgetPod("existing", "team-borrower", "800m"),
+				newBudgetPod("own", "team-lender", "1900m"))
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("batch", "team-borrower", "500m"))
+			Expect(err).To(MatchError(ContainSubstring("CPU Budget exceeded")))
+		})
+	})
+
+	Context("When a budget defaults missing limits", func() {
+		var (
+			now           time.Time
+			defaultBudget *finopsv1.ProjectBudget
+			limitRange    *corev1.LimitRange
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+			defaultBudget = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-defaults", Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:       "team-defaults",
+					MaxCpuLimit:    "1000m",
+					MaxMemoryLimit: "1Gi",
+					Defaults: &finopsv1.ContainerDefaults{
+						CpuLimit:      "300m",
+						RequireLimits: true,
+					},
+				},
+			}
+			limitRange = &corev1.LimitRange{
+				ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "team-defaults"},
+				Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+					Type: corev1.LimitTypeContainer,
+					Default: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("256Mi"),
+					},
+				}}},
+			}
+		})
+
+		It("Should inject the budget defaults, then the LimitRange ones, and annotate the pod", func() {
+			v := newFakeValidator(now, defaultBudget, limitRange)
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "bare", Namespace: "team-defaults"},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			}
+
+			Expect(v.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Spec.Containers[0].Resources.Limits.Cpu().String()).To(Equal("300m"))
+			Expect(pod.Spec.Containers[0].Resources.Limits.Memory().String()).To(Equal("256Mi"))
+			Expect(pod.Annotations).To(HaveKeyWithValue(DefaultedResourcesAnnotation,
+				"app/limits.cpu=300m,app/limits.memory=256Mi"))
+
+			// The defaulted pod now counts against the budget
+			Expect(v.ValidateCreate(ctx, pod)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny pods still missing limits when limits are required", func() {
+			v := newFakeValidator(now, defaultBudget)
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("no-memory", "team-defaults", "100m"))
+			Expect(err).To(MatchError(ContainSubstring("must set resource limits. Missing: app (memory)")))
+
+			status := err.(apierrors.APIStatus).Status()
+			Expect(status.Reason).To(Equal(finopsv1.ReasonLimitsRequired))
+			Expect(status.Details.Causes).To(Equal([]metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   "spec.containers[0].resources.limits.memory",
+				Message: "container 'app' has no memory limit",
+			}}))
+		})
+
+		It("Should only report missing limits in DryRun mode", func() {
+			defaultBudget.Spec.ValidationMode = finopsv1.DryRunMode
+			v := newFakeValidator(now, defaultBudget)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("no-memory", "team-defaults", "100m"))).Error().NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When the pod belongs to a workload", func() {
+		var (
+			now         time.Time
+			ownerBudget *finopsv1.ProjectBudget
+			replicaSet  *appsv1.ReplicaSet
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+			ownerBudget = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "owner-budget", Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "team-owner", MaxCpuLimit: "1000m"},
+			}
+			replicaSet = &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+				Name:      "web-5f7c9d",
+				Namespace: "team-owner",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "deploy-uid", Controller: ptr.To(true),
+				}},
+			}}
+		})
+
+		ownedPod := func(apiVersion, kind, name string) *corev1.Pod {
+			pod := newBudgetPod("", "team-owner", "1500m")
+			pod.GenerateName = name + "-"
+			pod.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: apiVersion, Kind: kind, Name: name, UID: "owner-uid", Controller: ptr.To(true),
+			}}
+			return pod
+		}
+
+		newRecordingValidator := func(objs ...client.Object) (*PodCustomValidator, *record.FakeRecorder) {
+			v := newFakeValidator(now, objs...)
+			recorder := &record.FakeRecorder{Events: make(chan string, 10), IncludeObject: true}
+			v.Recorder = recorder
+			return v, recorder
+		}
+
+		It("Should resolve the Deployment behind the ReplicaSet of the pod", func() {
+			v, _ := newRecordingValidator(replicaSet)
+
+			Expect(v.workloadsOf(ctx, ownedPod("apps/v1", "ReplicaSet", "web-5f7c9d"))).To(Equal([]*corev1.ObjectReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-5f7c9d", Namespace: "team-owner", UID: "owner-uid"},
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "web", Namespace: "team-owner", UID: "deploy-uid"},
+			}))
+			Expect(v.workloadsOf(ctx, newBudgetPod("bare", "team-owner", "1"))).To(BeEmpty())
+		})
+
+		It("Should explain the denial on the ReplicaSet and the Deployment", func() {
+			v, recorder := newRecordingValidator(ownerBudget, replicaSet)
+
+			Expect(v.ValidateCreate(ctx, ownedPod("apps/v1", "ReplicaSet", "web-5f7c9d"))).Error().To(HaveOccurred())
+
+			Expect(recorder.Events).To(Receive(HavePrefix("Warning BudgetExceeded DENIED by FinOps")))
+			Expect(recorder.Events).To(Receive(And(
+				HavePrefix("Warning BudgetExceeded Pod web-5f7c9d- denied by FinOps budget owner-budget: DENIED by FinOps"),
+				HaveSuffix("involvedObject{kind=ReplicaSet,apiVersion=apps/v1}"))))
+			Expect(recorder.Events).To(Receive(HaveSuffix("involvedObject{kind=Deployment,apiVersion=apps/v1}")))
+		})
+
+		It("Should record DryRun violations on the Job and skip server-side dry-run requests", func() {
+			ownerBudget.Spec.ValidationMode = finopsv1.DryRunMode
+			v, recorder := newRecordingValidator(ownerBudget)
+
+			// The Job isn't found: its own controller is skipped
+			Expect(v.ValidateCreate(ctx, ownedPod("batch/v1", "Job", "report"))).Error().NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(HavePrefix("Warning DryRunViolation [DRY-RUN]")))
+			Expect(recorder.Events).To(Receive(And(
+				HavePrefix("Warning DryRunViolation [DRY-RUN] Pod report- would have been denied by FinOps budget owner-budget"),
+				HaveSuffix("involvedObject{kind=Job,apiVersion=batch/v1}"))))
+			Expect(recorder.Events).NotTo(Receive())
+
+			dryRunCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				DryRun: ptr.To(true),
+			}})
+			Expect(v.ValidateCreate(dryRunCtx, ownedPod("batch/v1", "Job", "report"))).Error().NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(HavePrefix("Warning DryRunViolation [DRY-RUN]")))
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
+
+	Context("When denying a pod", func() {
+		It("Should return a status listing every exceeded resource of the budget", func() {
+			v := newFakeValidator(time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC), &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "status-budget", Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "team-status", MaxCpuLimit: "1000m", MaxMemoryLimit: "1Gi"},
+			}, newBudgetPod("existing", "team-status", "800m"))
+			pod := newBudgetPod("big", "team-status", "500m")
+			pod.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory] = resource.MustParse("2Gi")
+
+			_, err := v.ValidateCreate(ctx, pod)
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("CPU Budget exceeded for team 'team-status'"))
+			Expect(err.Error()).To(ContainSubstring("RAM Budget exceeded for team 'team-status'"))
+
+			status := err.(apierrors.APIStatus).Status()
+			Expect(status.Reason).To(Equal(finopsv1.ReasonBudgetExceeded))
+			Expect(status.Details.Name).To(Equal("status-budget"))
+			Expect(status.Details.Kind).To(Equal("ProjectBudget"))
+			Expect(status.Details.Causes).To(Equal([]metav1.StatusCause{
+				{Type: finopsv1.CauseResourceExceeded, Field: "cpu", Message: "used=800m limit=1 requested=500m"},
+				{Type: finopsv1.CauseResourceExceeded, Field: "memory", Message: "used=0 limit=1Gi requested=2Gi"},
+			}))
+		})
+
+		It("Should name the ClusterBudget that denied the pod", func() {
+			v := newFakeValidator(time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC),
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-shared"}},
+				&finopsv1.ClusterBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: "platform-cap"},
+					Spec:       finopsv1.ClusterBudgetSpec{MaxCpuLimit: "500m"},
+				})
+
+			_, err := v.ValidateCreate(ctx, newBudgetPod("big", "team-shared", "1000m"))
+			status := err.(apierrors.APIStatus).Status()
+			Expect(status.Reason).To(Equal(finopsv1.ReasonClusterBudgetExceeded))
+			Expect(status.Details.Name).To(Equal("platform-cap"))
+			Expect(status.Details.Kind).To(Equal("ClusterBudget"))
+			Expect(status.Details.Causes).To(ConsistOf(
+				metav1.StatusCause{Type: finopsv1.CauseResourceExceeded, Field: "cpu", Message: "used=0 limit=500m requested=1"}))
+		})
+	})
+
+	Context("When recording admission metrics", func() {
+		var (
+			now           time.Time
+			metricsBudget *finopsv1.ProjectBudget
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+			metricsBudget = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "metrics-budget", Namespace: "default"},
+				Spec: finopsv1.ProjectBudgetSpec{
+					TeamName:       "team-metrics",
+					MaxCpuLimit:    "1000m",
+					MaxMemoryLimit: "1Gi",
+				},
+			}
+		})
+
+		It("Should count memory savings of enforced denials", func() {
+			v := newFakeValidator(now, metricsBudget)
+			pod := newBudgetPod("hungry", "team-metrics", "100m")
+			pod.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory] = resource.MustParse("2Gi")
+
+			Expect(v.ValidateCreate(ctx, pod)).Error().To(HaveOccurred())
+			Expect(testutil.ToFloat64(rejectedPods.WithLabelValues("team-metrics", "metrics-budget", "memory"))).To(Equal(1.0))
+			Expect(testutil.ToFloat64(savedMemory.WithLabelValues("team-metrics", "metrics-budget"))).To(Equal(float64(2 << 30)))
+			Expect(testutil.ToFloat64(savedCpu.WithLabelValues("team-metrics", "metrics-budget"))).To(Equal(100.0))
+		})
+
+		It("Should count DryRun violations apart from rejections", func() {
+			metricsBudget.Spec.ValidationMode = finopsv1.DryRunMode
+			v := newFakeValidator(now, metricsBudget)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("big", "team-metrics", "2000m"))).Error().NotTo(HaveOccurred())
+			Expect(testutil.ToFloat64(dryRunViolations.WithLabelValues("team-metrics", "metrics-budget", "cpu"))).To(Equal(1.0))
+			Expect(testutil.ToFloat64(rejectedPods.WithLabelValues("team-metrics", "metrics-budget", "cpu"))).To(BeZero())
+		})
+
+		It("Should count the CPU trimmed by auto-sizing", func() {
+			v := newFakeValidator(now, metricsBudget, newBudgetPod("existing", "team-metrics", "800m"))
+			pod := newBudgetPod("resizable", "team-metrics", "500m")
+			pod.Annotations = map[string]string{"finops.acasa.acme/auto-resize": "true"}
+
+			Expect(v.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Spec.Containers[0].Resources.Limits.Cpu().String()).To(Equal("200m"))
+			Expect(testutil.ToFloat64(autoResizedPods.WithLabelValues("team-metrics", "metrics-budget"))).To(Equal(1.0))
+			Expect(testutil.ToFloat64(trimmedCpu.WithLabelValues("team-metrics", "metrics-budget"))).To(Equal(300.0))
+		})
+
+		It("Should count each decision outcome and time the admission phases", func() {
+			decisions := func(decision string) float64 {
+				return testutil.ToFloat64(admissionDecisions.WithLabelValues(decision))
+			}
+			allowed, denied, exempt := decisions("allowed"), decisions("denied"), decisions("exempt")
+			v := newFakeValidator(now, metricsBudget)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("small", "team-metrics", "100m"))).Error().NotTo(HaveOccurred())
+			Expect(v.ValidateCreate(ctx, newBudgetPod("big", "team-metrics", "2000m"))).Error().To(HaveOccurred())
+			Expect(v.ValidateCreate(ctx, newBudgetPod("free", "no-budget", "2000m"))).Error().NotTo(HaveOccurred())
+
+			Expect(decisions("allowed")).To(Equal(allowed + 1))
+			Expect(decisions("denied")).To(Equal(denied + 1))
+			Expect(decisions("exempt")).To(Equal(exempt + 1))
+			Expect(testutil.CollectAndCount(admissionPhaseDuration)).To(Equal(3))
+		})
+	})
+
+	Context("When keeping a history of violations", func() {
+		var (
+			now           time.Time
+			historyBudget *finopsv1.ProjectBudget
+		)
+
+		BeforeEach(func() {
+			now = time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+			historyBudget = &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "history-budget", Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "team-history", MaxCpuLimit: "1000m"},
+			}
+		})
+
+		violations := func(v *PodCustomValidator) []finopsv1.BudgetViolation {
+			var list finopsv1.BudgetViolationList
+			Expect(v.Client.List(ctx, &list, client.InNamespace("team-history"))).To(Succeed())
+			return list.Items
+		}
+
+		It("Should record a denied pod until the TTL expires", func() {
+			v := newFakeValidator(now, historyBudget)
+			v.ViolationTTL = 24 * time.Hour
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("big", "team-history", "1500m"))).Error().To(HaveOccurred())
+
+			items := violations(v)
+			Expect(items).To(HaveLen(1))
+			Expect(items[0].Labels).To(HaveKeyWithValue(finopsv1.BudgetNameLabel, "history-budget"))
+			Expect(items[0].Spec.BudgetKind).To(Equal("ProjectBudget"))
+			Expect(items[0].Spec.Action).To(Equal(finopsv1.ViolationDenied))
+			Expect(items[0].Spec.Reason).To(Equal(string(finopsv1.ReasonBudgetExceeded)))
+			Expect(items[0].Spec.Pod).To(Equal("big"))
+			Expect(items[0].Spec.Resources).To(Equal([]finopsv1.ViolatedResource{
+				{Resource: "cpu", Used: "0", Limit: "1", Requested: "1500m"},
+			}))
+			Expect(items[0].Spec.ExpiresAt.Time.Equal(now.Add(24 * time.Hour))).To(BeTrue())
+		})
+
+		It("Should record DryRun violations but not server-side dry-run requests", func() {
+			historyBudget.Spec.ValidationMode = finopsv1.DryRunMode
+			v := newFakeValidator(now, historyBudget)
+			v.ViolationTTL = 24 * time.Hour
+
+			dryRunCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				DryRun: ptr.To(true),
+			}})
+			Expect(v.ValidateCreate(dryRunCtx, newBudgetPod("preview", "team-history", "1500m"))).Error().NotTo(HaveOccurred())
+			Expect(violations(v)).To(BeEmpty())
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("big", "team-history", "1500m"))).Error().NotTo(HaveOccurred())
+			items := violations(v)
+			Expect(items).To(HaveLen(1))
+			Expect(items[0].Spec.Action).To(Equal(finopsv1.ViolationDryRun))
+		})
+
+		It("Should not record anything without a TTL", func() {
+			v := newFakeValidator(now, historyBudget)
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("big", "team-history", "1500m"))).Error().To(HaveOccurred())
+			Expect(violations(v)).To(BeEmpty())
+		})
+	})
+
+	Context("When auditing admissions", func() {
+		var (
+			v       *PodCustomValidator
+			records *bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			v = newFakeValidator(time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC), &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "audited-budget", Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "team-audited", MaxCpuLimit: "1000m"},
+			}, newBudgetPod("existing", "team-audited", "600m"))
+			records = &bytes.Buffer{}
+			v.Audit = audit.NewWriterSink(records)
+		})
+
+		decoded := func() []audit.Record {
+			var out []audit.Record
+			for _, line := range strings.Split(strings.TrimSpace(records.String()), "\n") {
+				var rec audit.Record
+				Expect(json.Unmarshal([]byte(line), &rec)).To(Succeed())
+				out = append(out, rec)
+			}
+			return out
+		}
+
+		It("Should write every decision with the user and the budget figures", func() {
+			reqCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				UserInfo: authenticationv1.UserInfo{Username: "alice"},
+			}})
+
+			Expect(v.ValidateCreate(reqCtx, newBudgetPod("small", "team-audited", "200m"))).Error().NotTo(HaveOccurred())
+			Expect(v.ValidateCreate(reqCtx, newBudgetPod("big", "team-audited", "800m"))).Error().To(HaveOccurred())
+			Expect(v.ValidateCreate(reqCtx, newBudgetPod("free", "no-budget", "800m"))).Error().NotTo(HaveOccurred())
+
+			recs := decoded()
+			Expect(recs).To(HaveLen(3))
+			Expect(recs[0].Decision).To(Equal("allowed"))
+			Expect(recs[0].User).To(Equal("alice"))
+			Expect(recs[0].Budget).To(Equal("audited-budget"))
+			Expect(recs[0].Request.CPU).To(Equal("200m"))
+			Expect(recs[0].Used.CPU).To(Equal("600m"))
+			Expect(recs[0].Limit.CPU).To(Equal("1"))
+
+			Expect(recs[1].Decision).To(Equal("denied"))
+			Expect(recs[1].Reason).To(Equal(string(finopsv1.ReasonBudgetExceeded)))
+			Expect(recs[1].Message).To(ContainSubstring("CPU Budget exceeded for team 'team-audited'"))
+			Expect(recs[1].Causes).To(HaveLen(1))
+			Expect(recs[1].Causes[0].Message).To(Equal("used=600m limit=1 requested=800m"))
+
+			Expect(recs[2].Decision).To(Equal("exempt"))
+			Expect(recs[2].Budget).To(BeEmpty())
+		})
+
+		It("Should not write server-side dry-run requests", func() {
+			dryRunCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				DryRun: ptr.To(true),
+			}})
+
+			Expect(v.ValidateCreate(dryRunCtx, newBudgetPod("preview", "team-audited", "800m"))).Error().To(HaveOccurred())
+			Expect(records.Len()).To(BeZero())
+		})
+
+		It("Should write the pods shrunk by auto-sizing", func() {
+			pod := newBudgetPod("", "team-audited", "800m")
+			pod.GenerateName = "resizable-"
+			pod.Annotations = map[string]string{"finops.acasa.acme/auto-resize": "true"}
+
+			Expect(v.Default(ctx, pod)).To(Succeed())
+			recs := decoded()
+			Expect(recs).To(HaveLen(1))
+			Expect(recs[0].Decision).To(Equal("resized"))
+			Expect(recs[0].Pod).To(Equal("resizable-"))
+			Expect(recs[0].Request.CPU).To(Equal("400m"))
+		})
+	})
+
+	Context("When tracing admissions", func() {
+		It("Should record the lookup, usage and decision of a denied pod", func() {
+			spans := tracetest.NewSpanRecorder()
+			v := newFakeValidator(time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC), &finopsv1.ProjectBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "traced-budget", Namespace: "default"},
+				Spec:       finopsv1.ProjectBudgetSpec{TeamName: "team-traced", MaxCpuLimit: "500m"},
+			})
+			v.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+
+			Expect(v.ValidateCreate(ctx, newBudgetPod("big", "team-traced", "1000m"))).Error().To(HaveOccurred())
+
+			ended := spans.Ended()
+			names := make([]string, 0, len(ended))
+			for _, span := range ended {
+				names = append(names, span.Name())
+			}
+			Expect(names).To(Equal([]string{"budget.lookup", "usage.calculate", "PodCustomValidator.ValidateCreate"}))
+
+			root := ended[2]
+			Expect(root.Attributes()).To(ContainElements(
+				tracing.NamespaceKey.String("team-traced"),
+				tracing.BudgetKey.String("traced-budget"),
+				tracing.DecisionKey.String("denied"),
+			))
+			Expect(root.Events()).To(HaveLen(1))
+			Expect(root.Events()[0].Name).To(Equal("violation"))
+			Expect(ended[0].Parent().SpanID()).To(Equal(root.SpanContext().SpanID()))
+		})
+	})
 })
@@ -0,0 +1,189 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+	"github.com/AlejandroCasa/k8s-governance-operator/internal/budget"
+)
+
+// log is for logging in this package.
+var projectbudgetlog = logf.Log.WithName("projectbudget-resource")
+
+// SetupProjectBudgetWebhookWithManager registers the webhook for ProjectBudget in the manager.
+func SetupProjectBudgetWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&finopsv1.ProjectBudget{}).
+		WithValidator(&ProjectBudgetCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-finops-acasa-acme-v1-projectbudget,mutating=false,failurePolicy=fail,sideEffects=None,groups=finops.acasa.acme,resources=projectbudgets,verbs=create;update;delete,versions=v1,name=vprojectbudget-v1.kb.io,admissionReviewVersions=v1
+
+// ProjectBudgetCustomValidator keeps budget hierarchies consistent: parents must exist, the
+// chain may not loop, and the limits of the children of a budget may not exceed its own.
+type ProjectBudgetCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &ProjectBudgetCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ProjectBudget.
+func (v *ProjectBudgetCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	pb, ok := obj.(*finopsv1.ProjectBudget)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProjectBudget object but got %T", obj)
+	}
+	projectbudgetlog.Info("Validation for ProjectBudget upon creation", "name", pb.GetName())
+
+	return nil, v.validateHierarchy(ctx, pb)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ProjectBudget.
+// The hierarchy is only checked again when the parent or the limits change: metadata updates (finalizers)
+// and budgets being deleted must go through even when their parent is gone.
+func (v *ProjectBudgetCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	pb, ok := newObj.(*finopsv1.ProjectBudget)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProjectBudget object for the newObj but got %T", newObj)
+	}
+	old, ok := oldObj.(*finopsv1.ProjectBudget)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProjectBudget object for the oldObj but got %T", oldObj)
+	}
+	projectbudgetlog.Info("Validation for ProjectBudget upon update", "name", pb.GetName())
+
+	if !pb.DeletionTimestamp.IsZero() || !hierarchyChanged(old, pb) {
+		return nil, nil
+	}
+	return nil, v.validateHierarchy(ctx, pb)
+}
+
+// hierarchyChanged reports whether the update touches what validateHierarchy checks.
+func hierarchyChanged(old, pb *finopsv1.ProjectBudget) bool {
+	return !equality.Semantic.DeepEqual(old.Spec.ParentRef, pb.Spec.ParentRef) ||
+		old.Spec.MaxCpuLimit != pb.Spec.MaxCpuLimit ||
+		old.Spec.MaxMemoryLimit != pb.Spec.MaxMemoryLimit
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ProjectBudget.
+// Deleting a parent is allowed (with a warning), but its children deny new pods with a ParentBudgetMissing
+// violation until they are re-parented.
+func (v *ProjectBudgetCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	pb, ok := obj.(*finopsv1.ProjectBudget)
+	if !ok {
+		return nil, fmt.Errorf("expected a ProjectBudget object but got %T", obj)
+	}
+
+	var budgetList finopsv1.ProjectBudgetList
+	if err := v.Client.List(ctx, &budgetList); err != nil {
+		return nil, nil // Fail-open: deletions are never blocked
+	}
+	var warnings admission.Warnings
+	for _, child := range budget.Children(pb, budgetList.Items) {
+		warnings = append(warnings, fmt.Sprintf("ProjectBudget %s/%s still references %s as its parent",
+			child.Namespace, child.Name, pb.Name))
+	}
+	return warnings, nil
+}
+
+// validateHierarchy checks pb against the other budgets as if it was already stored.
+func (v *ProjectBudgetCustomValidator) validateHierarchy(ctx context.Context, pb *finopsv1.ProjectBudget) error {
+	var budgetList finopsv1.ProjectBudgetList
+	if err := v.Client.List(ctx, &budgetList); err != nil {
+		return fmt.Errorf("failed to list budgets: %w", err)
+	}
+
+	// Replace the stored version of pb (if any) with the one being admitted
+	budgets := []finopsv1.ProjectBudget{*pb}
+	for _, b := range budgetList.Items {
+		if budget.Key(&b) != budget.Key(pb) {
+			budgets = append(budgets, b)
+		}
+	}
+	pb = &budgets[0]
+
+	var errs field.ErrorList
+	parentPath := field.NewPath("spec", "parentRef")
+
+	// 1. The parent chain must exist and end without looping
+	ancestors, err := budget.Ancestors(pb, budgets)
+	if err != nil {
+		errs = append(errs, field.Invalid(parentPath, pb.Spec.ParentRef, err.Error()))
+	}
+
+	// 2. This budget (with its siblings) must fit in its parent
+	if len(ancestors) > 0 {
+		if err := childrenFit(ancestors[0], budgets); err != nil {
+			errs = append(errs, field.Invalid(parentPath, pb.Spec.ParentRef, err.Error()))
+		}
+	}
+
+	// 3. The children of this budget must still fit in it
+	if err := childrenFit(pb, budgets); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "maxCpuLimit"), pb.Spec.MaxCpuLimit, err.Error()))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(finopsv1.GroupVersion.WithKind("ProjectBudget").GroupKind(), pb.Name, errs)
+}
+
+// childrenFit checks that the limits of the children of parent add up to no more than its own.
+func childrenFit(parent *finopsv1.ProjectBudget, budgets []finopsv1.ProjectBudget) error {
+	children := budget.Children(parent, budgets)
+	if len(children) == 0 {
+		return nil
+	}
+
+	parentLimits, err := budget.Limits(&parent.Spec)
+	if err != nil {
+		return fmt.Errorf("invalid limits in parent budget %s: %w", parent.Name, err)
+	}
+	var total budget.Resources
+	for _, child := range children {
+		limits, err := budget.Limits(&child.Spec)
+		if err != nil {
+			return fmt.Errorf("invalid limits in child budget %s: %w", child.Name, err)
+		}
+		total = total.Add(limits)
+	}
+
+	if total.CPUMilli > parentLimits.CPUMilli {
+		return fmt.Errorf("child budgets of %s add up to %dm CPU, more than its limit of %dm",
+			parent.Name, total.CPUMilli, parentLimits.CPUMilli)
+	}
+	if parentLimits.MemoryBytes > 0 && total.MemoryBytes > parentLimits.MemoryBytes {
+		return fmt.Errorf("child budgets of %s add up to %d bytes of Memory, more than its limit of %d bytes",
+			parent.Name, total.MemoryBytes, parentLimits.MemoryBytes)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// newFakeBudgetValidator builds a ProjectBudgetCustomValidator backed by a fake client holding objs.
+func newFakeBudgetValidator(objs ...client.Object) *ProjectBudgetCustomValidator {
+	s := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(s)).To(Succeed())
+	Expect(finopsv1.AddToScheme(s)).To(Succeed())
+
+	return &ProjectBudgetCustomValidator{
+		Client: fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(),
+	}
+}
+
+// newChildBudget returns a budget for namespace ns with the given CPU limit under parent.
+func newChildBudget(name, ns, cpu, parent string) *finopsv1.ProjectBudget {
+	pb := &finopsv1.ProjectBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       finopsv1.ProjectBudgetSpec{TeamName: ns, MaxCpuLimit: cpu},
+	}
+	if parent != "" {
+		pb.Spec.ParentRef = &finopsv1.BudgetReference{Name: parent}
+	}
+	return pb
+}
+
+var _ = Describe("ProjectBudget Webhook", func() {
+	var department *finopsv1.ProjectBudget
+
+	BeforeEach(func() {
+		department = newChildBudget("data", "data-shared", "4000m", "")
+	})
+
+	Context("When creating a child budget", func() {
+		It("Should admit children that fit in the parent", func() {
+			v := newFakeBudgetValidator(department, newChildBudget("team-a", "team-a", "2000m", "data"))
+
+			Expect(v.ValidateCreate(ctx, newChildBudget("team-b", "team-b", "2000m", "data"))).To(BeEmpty())
+		})
+
+		It("Should deny children whose limits add up to more than the parent", func() {
+			v := newFakeBudgetValidator(department, newChildBudget("team-a", "team-a", "3000m", "data"))
+
+			_, err := v.ValidateCreate(ctx, newChildBudget("team-b", "team-b", "2000m", "data"))
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("add up to 5000m CPU, more than its limit of 4000m")))
+		})
+
+		It("Should deny a missing parent", func() {
+			v := newFakeBudgetValidator()
+
+			_, err := v.ValidateCreate(ctx, newChildBudget("team-a", "team-a", "1000m", "data"))
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+		})
+	})
+
+	Context("When updating a budget", func() {
+		It("Should deny shrinking a parent below its children", func() {
+			v := newFakeBudgetValidator(department, newChildBudget("team-a", "team-a", "3000m", "data"))
+
+			shrunk := department.DeepCopy()
+			shrunk.Spec.MaxCpuLimit = "2000m"
+			_, err := v.ValidateUpdate(ctx, department, shrunk)
+			Expect(err).To(MatchError(ContainSubstring("add up to 3000m CPU")))
+		})
+
+		It("Should deny a parent reference that loops back", func() {
+			child := newChildBudget("team-a", "team-a", "1000m", "data")
+			v := newFakeBudgetValidator(department, child)
+
+			looping := department.DeepCopy()
+			looping.Spec.ParentRef = &finopsv1.BudgetReference{Name: "team-a"}
+			_, err := v.ValidateUpdate(ctx, department, looping)
+			Expect(err).To(MatchError(ContainSubstring("loops back")))
+		})
+	})
+
+	Context("When updating a budget whose parent was deleted", func() {
+		var child *finopsv1.ProjectBudget
+
+		BeforeEach(func() {
+			child = newChildBudget("team-a", "team-a", "1000m", "data")
+		})
+
+		It("Should admit metadata-only updates such as finalizer removals", func() {
+			v := newFakeBudgetValidator(child)
+
+			released := child.DeepCopy()
+			released.Finalizers = nil
+			child.Finalizers = []string{"finops.acasa.acme/resourcequota"}
+			Expect(v.ValidateUpdate(ctx, child, released)).To(BeEmpty())
+		})
+
+		It("Should admit updates of a budget being deleted", func() {
+			v := newFakeBudgetValidator(child)
+
+			deleting := child.DeepCopy()
+			now := metav1.Now()
+			deleting.DeletionTimestamp = &now
+			deleting.Spec.MaxCpuLimit = "2000m"
+			Expect(v.ValidateUpdate(ctx, child, deleting)).To(BeEmpty())
+		})
+
+		It("Should still deny changing its limits", func() {
+			v := newFakeBudgetValidator(child)
+
+			grown := child.DeepCopy()
+			grown.Spec.MaxCpuLimit = "2000m"
+			_, err := v.ValidateUpdate(ctx, child, grown)
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+		})
+	})
+
+	Context("When deleting a budget", func() {
+		It("Should warn about children left without a parent", func() {
+			v := newFakeBudgetValidator(department, newChildBudget("team-a", "team-a", "1000m", "data"))
+
+			warnings, err := v.ValidateDelete(ctx, department)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("default/team-a still references data")))
+		})
+	})
+})
@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	finopsv1 "github.com/AlejandroCasa/k8s-governance-operator/api/v1"
+)
+
+// DefaultViolationTTL is how long BudgetViolations are kept unless configured otherwise.
+const DefaultViolationTTL = 30 * 24 * time.Hour
+
+// recordViolation keeps a BudgetViolation in the namespace of the pod until the TTL expires.
+// Server-side dry-run requests are not recorded (the webhook declares sideEffects=NoneOnDryRun),
+// and failures are only logged.
+func (v *PodCustomValidator) recordViolation(ctx context.Context, budgetObj client.Object, pod *corev1.Pod,
+	vi violation, action finopsv1.ViolationAction) {
+	if v.ViolationTTL <= 0 {
+		return
+	}
+	if dryRunRequest(ctx) {
+		return
+	}
+	user := ""
+	if req, err := admission.RequestFromContext(ctx); err == nil {
+		user = req.UserInfo.Username
+	}
+
+	record := &finopsv1.BudgetViolation{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: budgetObj.GetName() + "-",
+			Namespace:    pod.Namespace,
+			Labels:       map[string]string{finopsv1.BudgetNameLabel: budgetObj.GetName()},
+		},
+		Spec: finopsv1.BudgetViolationSpec{
+			BudgetKind: budgetKind(budgetObj),
+			BudgetName: budgetObj.GetName(),
+			Source:     finopsv1.ViolationSourceAdmission,
+			Action:     action,
+			Reason:     string(vi.reason),
+			Message:    vi.message,
+			Pod:        podName(pod),
+			User:       user,
+			Resources:  vi.figures,
+			ExpiresAt:  metav1.NewTime(v.now().Add(v.ViolationTTL)),
+		},
+	}
+	if err := v.Client.Create(ctx, record); err != nil {
+		podlog.Error(err, "Failed to record BudgetViolation", "namespace", pod.Namespace, "budget", budgetObj.GetName())
+	}
+}
+
+// dryRunRequest tells whether the admission request is a server-side dry run (e.g., kubectl apply --dry-run=server).
+func dryRunRequest(ctx context.Context) bool {
+	req, err := admission.RequestFromContext(ctx)
+	return err == nil && req.DryRun != nil && *req.DryRun
+}
+
+// podName is the name of the pod, or its generateName prefix when the name is not assigned yet.
+func podName(pod *corev1.Pod) string {
+	if pod.Name == "" {
+		return pod.GenerateName
+	}
+	return pod.Name
+}
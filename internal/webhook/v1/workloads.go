@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch
+
+// workloadParents are the controllers whose own controller is worth an event too: developers
+// describe the Deployment or CronJob, not the ReplicaSet or Job in between.
+var workloadParents = map[schema.GroupKind]schema.GroupVersionKind{
+	{Group: appsv1.GroupName, Kind: "ReplicaSet"}: appsv1.SchemeGroupVersion.WithKind("ReplicaSet"),
+	{Group: batchv1.GroupName, Kind: "Job"}:       batchv1.SchemeGroupVersion.WithKind("Job"),
+}
+
+// workloadsOf returns the workloads controlling a pod: its controller (e.g., a ReplicaSet or a Job)
+// and, for ReplicaSets and Jobs, their own controller (e.g., a Deployment or a CronJob).
+// Controllers that can't be read are skipped.
+func (v *PodCustomValidator) workloadsOf(ctx context.Context, pod *corev1.Pod) []*corev1.ObjectReference {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return nil
+	}
+	refs := []*corev1.ObjectReference{workloadReference(pod.Namespace, owner)}
+
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil {
+		return refs
+	}
+	gvk, ok := workloadParents[schema.GroupKind{Group: gv.Group, Kind: owner.Kind}]
+	if !ok {
+		return refs
+	}
+	// Only the metadata is needed to follow the owner references
+	parent := &metav1.PartialObjectMetadata{}
+	parent.SetGroupVersionKind(gvk)
+	if err := v.Client.Get(ctx, types.NamespacedName{Name: owner.Name, Namespace: pod.Namespace}, parent); err != nil {
+		if !apierrors.IsNotFound(err) {
+			podlog.Error(err, "Failed to get pod controller", "kind", owner.Kind, "name", owner.Name, "namespace", pod.Namespace)
+		}
+		return refs
+	}
+	if grandOwner := metav1.GetControllerOf(parent); grandOwner != nil {
+		refs = append(refs, workloadReference(pod.Namespace, grandOwner))
+	}
+	return refs
+}
+
+// workloadReference points an event at the owner of an object.
+func workloadReference(namespace string, owner *metav1.OwnerReference) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion: owner.APIVersion,
+		Kind:       owner.Kind,
+		Name:       owner.Name,
+		Namespace:  namespace,
+		UID:        owner.UID,
+	}
+}
+
+// recordOnWorkloads records an event about the pod on the workloads controlling it, so
+// `kubectl describe deployment` explains why its pods are missing. Server-side dry-run
+// requests have no side effects.
+func (v *PodCustomValidator) recordOnWorkloads(ctx context.Context, pod *corev1.Pod, eventType, reason, message string) {
+	if dryRunRequest(ctx) {
+		return
+	}
+	for _, ref := range v.workloadsOf(ctx, pod) {
+		v.Recorder.Event(ref, eventType, reason, message)
+	}
+}